@@ -18,6 +18,7 @@ import (
 	"github.com/KuChainNetwork/kuchain/chain/constants"
 	"github.com/KuChainNetwork/kuchain/chain/fee"
 	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/plugins"
 	"github.com/KuChainNetwork/kuchain/test/simapp"
 	"github.com/KuChainNetwork/kuchain/x/account"
 	"github.com/KuChainNetwork/kuchain/x/asset"
@@ -78,6 +79,8 @@ type KuchainApp struct {
 	*bam.BaseApp
 	cdc *codec.Codec
 
+	txDecoder sdk.TxDecoder
+
 	invCheckPeriod uint
 
 	// keys to access the substores
@@ -107,6 +110,9 @@ type KuchainApp struct {
 
 	// function manager
 	stakingFuncManager staking.FuncManager
+
+	// events emitted by the ante handler of the tx in delivering
+	anteEvents sdk.Events
 }
 
 // custom tx codec
@@ -131,7 +137,8 @@ func NewKuchainApp(
 ) *KuchainApp {
 	cdc := MakeCodec()
 
-	bApp := bam.NewBaseApp(appName, logger, db, txutil.DefaultTxDecoder(cdc), baseAppOptions...)
+	txDecoder := txutil.DefaultTxDecoder(cdc)
+	bApp := bam.NewBaseApp(appName, logger, db, txDecoder, baseAppOptions...)
 	bApp.SetCommitMultiStoreTracer(traceStore)
 	bApp.SetAppVersion(version.Version)
 
@@ -144,6 +151,7 @@ func NewKuchainApp(
 	app := &KuchainApp{
 		BaseApp:        bApp,
 		cdc:            cdc,
+		txDecoder:      txDecoder,
 		invCheckPeriod: invCheckPeriod,
 		keys:           keys,
 		tKeys:          tKeys,
@@ -161,7 +169,7 @@ func NewKuchainApp(
 	app.subspaces[gov.ModuleName] = app.paramsKeeper.Subspace(gov.DefaultParamspace).WithKeyTable(gov.ParamKeyTable())
 
 	// add keepers
-	app.accountKeeper = account.NewAccountKeeper(cdc, keys[account.StoreKey])
+	app.accountKeeper = account.NewAccountKeeper(cdc, keys[account.StoreKey], app.subspaces[account.ModuleName])
 	app.assetKeeper = asset.NewAssetKeeper(cdc, keys[asset.StoreKey], app.accountKeeper)
 	app.supplyKeeper = supply.NewKeeper(
 		cdc, keys[supply.StoreKey], app.accountKeeper, app.assetKeeper, maccPerms,
@@ -189,9 +197,9 @@ func NewKuchainApp(
 	evidenceKeeper := evidence.NewKeeper(
 		keys[evidence.StoreKey], app.subspaces[evidence.ModuleName], &stakingKeeper, app.slashingKeeper,
 	)
-	evidenceRouter := evidence.NewRouter()
-
-	app.evidenceKeeper = *evidenceKeeper
+	evidenceRouter := evidence.NewRouter().
+		AddRoute(evidence.RouteLightClientAttack, evidence.LightClientAttackHandler(*evidenceKeeper)).
+		AddRoute(evidence.RouteInvalidPluginReport, evidence.InvalidPluginReportHandler(*evidenceKeeper))
 
 	// register the proposal types
 	govRouter := gov.NewRouter()
@@ -208,8 +216,9 @@ func NewKuchainApp(
 		staking.NewMultiStakingHooks(app.distrKeeper.Hooks(), app.slashingKeeper.Hooks()),
 	)
 
-	// TODO: register evidence routes
 	evidenceKeeper.SetRouter(evidenceRouter)
+	app.evidenceKeeper = *evidenceKeeper
+
 	app.mintKeeper = mint.NewKeeper(
 		cdc, keys[mint.StoreKey], app.subspaces[mint.ModuleName], &app.stakingKeeper,
 		app.supplyKeeper, constants.FeeSystemAccountStr,
@@ -218,8 +227,8 @@ func NewKuchainApp(
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
 	app.mm = module.NewManager(
-		account.NewAppModule(app.accountKeeper, app.assetKeeper),
-		genutil.NewAppModule(app.accountKeeper, app.stakingKeeper, app.BaseApp.DeliverTx, app.stakingFuncManager),
+		account.NewAppModule(app.accountKeeper, app.assetKeeper, app.stakingKeeper, app.distrKeeper),
+		genutil.NewAppModule(app.accountKeeper, app.stakingKeeper, app.DeliverTx, app.stakingFuncManager),
 		asset.NewAppModule(app.accountKeeper, app.assetKeeper),
 		supply.NewAppModule(app.supplyKeeper, app.assetKeeper, app.accountKeeper),
 		distr.NewAppModule(app.distrKeeper, app.accountKeeper, app.assetKeeper, app.supplyKeeper, app.stakingKeeper),
@@ -232,7 +241,7 @@ func NewKuchainApp(
 	)
 
 	// plugin.ModuleName MUST be the last
-	app.mm.SetOrderBeginBlockers(mint.ModuleName, distr.ModuleName, slashing.ModuleName, evidence.ModuleName, plugin.ModuleName)
+	app.mm.SetOrderBeginBlockers(account.ModuleName, mint.ModuleName, distr.ModuleName, staking.ModuleName, slashing.ModuleName, evidence.ModuleName, plugin.ModuleName)
 	app.mm.SetOrderEndBlockers(staking.ModuleName, gov.ModuleName, plugin.ModuleName)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -255,7 +264,7 @@ func NewKuchainApp(
 	// NOTE: This is not required for apps that don't use the simulator for fuzz testing
 	// transactions.
 	app.sm = module.NewSimulationManager(
-		account.NewAppModule(app.accountKeeper, app.assetKeeper),
+		account.NewAppModule(app.accountKeeper, app.assetKeeper, app.stakingKeeper, app.distrKeeper),
 		supply.NewAppModule(app.supplyKeeper, app.assetKeeper, app.accountKeeper),
 		distr.NewAppModule(app.distrKeeper, app.accountKeeper, app.assetKeeper, app.supplyKeeper, app.stakingKeeper),
 		staking.NewAppModule(app.stakingKeeper, app.accountKeeper, app.assetKeeper, app.supplyKeeper),
@@ -274,7 +283,7 @@ func NewKuchainApp(
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 
-	app.SetAnteHandler(ante.NewHandler(app.accountKeeper, app.assetKeeper))
+	app.SetAnteHandler(app.collectAnteEvents(ante.NewHandler(app.accountKeeper, app.assetKeeper)))
 
 	app.SetEndBlocker(app.EndBlocker)
 
@@ -303,11 +312,50 @@ func (app *KuchainApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abc
 	return app.mm.EndBlock(ctx, req)
 }
 
+// DeliverTx delivers the tx and reports its result to plugins, the events of the ante handler,
+// which are dropped by baseapp, are added to the response, so the fee paid can be tracked by
+// events even if the msgs failed
+func (app *KuchainApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+	app.anteEvents = nil
+
+	res := app.BaseApp.DeliverTx(req)
+	res.Events = append(app.anteEvents.ToABCIEvents(), res.Events...)
+	app.anteEvents = nil
+
+	plugins.HandleDeliverTx(app.txDecoder, req, res)
+	return res
+}
+
+// collectAnteEvents wraps the ante handler to collect its events in DeliverTx, the events are
+// only collected if it succeeded, as its state changes are dropped if failed
+func (app *KuchainApp) collectAnteEvents(handler sdk.AnteHandler) sdk.AnteHandler {
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		events := sdk.NewEventManager()
+
+		newCtx, err := handler(ctx.WithEventManager(events), tx, simulate)
+		if err == nil && !ctx.IsCheckTx() && !simulate {
+			app.anteEvents = events.Events()
+		}
+
+		return newCtx, err
+	}
+}
+
+// Commit commits the block and notifies plugins the block is final
+func (app *KuchainApp) Commit() abci.ResponseCommit {
+	res := app.BaseApp.Commit()
+	plugins.HandleCommit(app.LastBlockHeight(), res.Data)
+	return res
+}
+
 // InitChainer application update at chain initialization
 func (app *KuchainApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
 	var genesisState simapp.GenesisState
 	app.cdc.MustUnmarshalJSON(req.AppStateBytes, &genesisState)
-	return app.mm.InitGenesis(ctx, genesisState)
+	res := app.mm.InitGenesis(ctx, genesisState)
+
+	plugins.HandleInitChain(ctx)
+	return res
 }
 
 // LoadHeight loads a particular height
@@ -17,12 +17,14 @@ func NewHandler(ak keeper.AccountKeeper, asset AssetKeeper) sdk.AnteHandler {
 	return sdk.ChainAnteDecorators(
 		NewSetUpContextDecorator(),
 		NewValidateBasicDecorator(),
+		NewTxTimeoutDecorator(),
 		NewMempoolFeeDecorator(),
 		NewConsumeGasForTxSizeDecorator(),
+		NewConsumeMsgGasDecorator(ak),
 		NewDeductFeeDecorator(ak, asset),
 		NewSetPubKeyDecorator(ak),
 		NewSigVerificationDecorator(ak),
+		NewUnorderedTxDecorator(ak),
 		NewIncrementSequenceDecorator(ak),
-		NewPluginHandlerDecorator(),
 	)
 }
@@ -21,3 +21,16 @@ type AssetKeeper interface {
 type AccountKeeper interface {
 	GetAccount(ctx sdk.Context, id AccountID) exported.Account
 }
+
+// TimeoutTx defines a Tx interface for txs which can be timeout
+type TimeoutTx interface {
+	types.Tx
+	GetTimeoutHeight() uint64
+	GetExpiration() int64
+}
+
+// UnorderedTx defines a Tx interface for txs which can be unordered
+type UnorderedTx interface {
+	TimeoutTx
+	IsUnordered() bool
+}
@@ -0,0 +1,29 @@
+package ante
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/account/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ConsumeMsgGasDecorator consumes the base gas cost of each msg in tx, the costs are from the gas schedule
+// keyed by the router and action of msg in the params of account module, which can be changed by proposals.
+type ConsumeMsgGasDecorator struct {
+	ak keeper.AccountKeeper
+}
+
+func NewConsumeMsgGasDecorator(ak keeper.AccountKeeper) ConsumeMsgGasDecorator {
+	return ConsumeMsgGasDecorator{
+		ak: ak,
+	}
+}
+
+func (cmgd ConsumeMsgGasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	schedule := cmgd.ak.MsgGasSchedule(ctx)
+	for _, msg := range tx.GetMsgs() {
+		if gas := schedule.GasOf(msg.Route(), msg.Type()); gas > 0 {
+			ctx.GasMeter().ConsumeGas(gas, "msg "+msg.Route()+"/"+msg.Type())
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
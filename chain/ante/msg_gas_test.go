@@ -0,0 +1,62 @@
+package ante_test
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/KuChainNetwork/kuchain/chain/ante"
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	"github.com/KuChainNetwork/kuchain/x/account"
+	accountTypes "github.com/KuChainNetwork/kuchain/x/account/types"
+	"github.com/KuChainNetwork/kuchain/x/params"
+	paramproposal "github.com/KuChainNetwork/kuchain/x/params/types/proposal"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestConsumeMsgGas(t *testing.T) {
+	app, ctx := createAppForTest()
+
+	Convey("test msg gas by the schedule in params", t, func() {
+		antehandler := sdk.ChainAnteDecorators(ante.NewConsumeMsgGasDecorator(*app.AccountKeeper()))
+		tx := testStdTx(app, account1, account2)
+
+		// gas consumed by the msgs, without the gas of reading the schedule from params
+		consumed := func() uint64 {
+			readCtx := ctx.WithGasMeter(sdk.NewGasMeter(10000000))
+			app.AccountKeeper().MsgGasSchedule(readCtx)
+
+			anteCtx := ctx.WithGasMeter(sdk.NewGasMeter(10000000))
+			newCtx, err := antehandler(anteCtx, tx, false)
+			So(err, ShouldBeNil)
+			return newCtx.GasMeter().GasConsumed() - readCtx.GasMeter().GasConsumed()
+		}
+		msgGas := func(router, action string) uint64 {
+			return app.AccountKeeper().MsgGasSchedule(ctx).GasOf(router, action)
+		}
+
+		// transfers are not in the default schedule
+		So(msgGas("account", "create@account"), ShouldEqual, constants.GasMsgCreateAccount)
+		So(consumed(), ShouldEqual, 0)
+
+		// changed by a params proposal, the cost of router applies to all actions
+		handler := params.NewParamChangeProposalHandler(*app.ParamsKeeper())
+		err := handler(ctx, paramproposal.NewParameterChangeProposal("gas", "msg gas", []paramproposal.ParamChange{
+			paramproposal.NewParamChange(account.DefaultParamspace, string(accountTypes.KeyMsgGasSchedule),
+				`[{"router":"asset","action":"","gas":"3000"},{"router":"asset","action":"transfer","gas":"1000"}]`),
+		}))
+		So(err, ShouldBeNil)
+
+		So(msgGas("asset", "transfer"), ShouldEqual, 1000)
+		So(msgGas("asset", "issue"), ShouldEqual, 3000)
+		So(msgGas("account", "create@account"), ShouldEqual, 0)
+		So(consumed(), ShouldEqual, 2*msgGas(tx.Msgs[0].Route(), tx.Msgs[0].Type()))
+
+		// duplicated costs are rejected
+		err = handler(ctx, paramproposal.NewParameterChangeProposal("gas", "msg gas", []paramproposal.ParamChange{
+			paramproposal.NewParamChange(account.DefaultParamspace, string(accountTypes.KeyMsgGasSchedule),
+				`[{"router":"asset","action":"","gas":"3000"},{"router":"asset","action":"","gas":"1000"}]`),
+		}))
+		So(err, ShouldNotBeNil)
+	})
+}
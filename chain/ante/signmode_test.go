@@ -0,0 +1,92 @@
+package ante_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/KuChainNetwork/kuchain/chain/ante"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	assetTypes "github.com/KuChainNetwork/kuchain/x/asset/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestTextualSignMode(t *testing.T) {
+	app, ctx := createAppForTest()
+
+	Convey("test textual sign mode", t, func() {
+		ak := app.AccountKeeper()
+		antehandler := sdk.ChainAnteDecorators(
+			ante.NewSetPubKeyDecorator(*ak),
+			ante.NewSigVerificationDecorator(*ak))
+
+		seq, num, err := ak.GetAuthSequence(ctx, addr2)
+		So(err, ShouldBeNil)
+
+		msg := assetTypes.NewMsgIssue(addr2, name5, types.MustName("coin"), types.NewInt64Coin("foo/coin", 10))
+		signMsg := types.StdSignMsg{
+			ChainID:       ctx.ChainID(),
+			AccountNumber: num,
+			Sequence:      seq,
+			Fee:           testStdTx(app, account2).Fee,
+			Msg:           []sdk.Msg{&msg},
+		}
+
+		directBytes, err := signMsg.SignBytes(app.Codec(), types.SignModeDirect)
+		So(err, ShouldBeNil)
+		So(directBytes, ShouldResemble, signMsg.Bytes())
+
+		// the msg data is decoded in the textual sign bytes
+		textualBytes, err := signMsg.SignBytes(app.Codec(), types.SignModeTextual)
+		So(err, ShouldBeNil)
+		So(string(textualBytes), ShouldContainSubstring, `"sign_mode":"textual"`)
+		So(string(textualBytes), ShouldContainSubstring, `"creator":"foo"`)
+		So(string(directBytes), ShouldNotContainSubstring, `"creator":"foo"`)
+
+		priv := wallet.PrivKey(addr2)
+		sign := func(bz []byte, mode types.SignMode) types.StdTx {
+			sig, err := priv.Sign(bz)
+			So(err, ShouldBeNil)
+
+			return signMsg.StdTx([]types.StdSignature{{PubKey: priv.PubKey(), Signature: sig, SignMode: mode}})
+		}
+
+		_, err = antehandler(ctx, sign(textualBytes, types.SignModeTextual), false)
+		So(err, ShouldBeNil)
+
+		_, err = antehandler(ctx, sign(directBytes, types.SignModeDirect), false)
+		So(err, ShouldBeNil)
+
+		// the signature is verified by the bytes of its sign mode
+		_, err = antehandler(ctx, sign(textualBytes, types.SignModeDirect), false)
+		So(err, ShouldNotBeNil)
+
+		_, err = antehandler(ctx, sign(directBytes, types.SignModeTextual), false)
+		So(err, ShouldNotBeNil)
+
+		_, err = antehandler(ctx, sign(directBytes, types.SignMode(100)), false)
+		So(err, ShouldNotBeNil)
+
+		// amino skips unknown fields, so a field appended to the data does not change the decoded json,
+		// the data must be canonical encoded to be signed in textual mode
+		textualTx := sign(textualBytes, types.SignModeTextual)
+		tampered := msg
+		tampered.Data = appendUnknownField(msg.Data)
+		textualTx.Msgs = []sdk.Msg{&tampered}
+
+		_, err = antehandler(ctx, textualTx, false)
+		So(err, ShouldNotBeNil)
+		So(err.Error(), ShouldContainSubstring, types.ErrKuMsgDataNotCanonical.Error())
+	})
+}
+
+// appendUnknownField appends a varint field with an unused field number to the length prefixed amino data
+func appendUnknownField(data []byte) []byte {
+	size, n := binary.Uvarint(data)
+	body := append(append([]byte{}, data[n:n+int(size)]...), 0x78, 0x01)
+
+	prefix := make([]byte, binary.MaxVarintLen64)
+	prefix = prefix[:binary.PutUvarint(prefix, uint64(len(body)))]
+	return append(prefix, body...)
+}
@@ -65,8 +65,16 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 			return ctx, err
 		}
 
-		// retrieve signBytes of tx
-		signBytes := txutil.GetSignBytes(ctx, &stdTx, num, seq)
+		// unordered txs are signed without sequence
+		if stdTx.IsUnordered() {
+			seq = 0
+		}
+
+		// retrieve signBytes of tx in the sign mode of the signature
+		signBytes, err := txutil.GetSignBytesByMode(ctx, svd.ak.Cdc(), &stdTx, sig.SignMode, num, seq)
+		if err != nil {
+			return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "get sign bytes failed: %s", err.Error())
+		}
 
 		// retrieve pubkey
 		pubKey := sig.PubKey
@@ -105,6 +113,11 @@ func (isd IncrementSequenceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, sim
 		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type for SigVerifiableTx in inc seq")
 	}
 
+	// unordered txs do not use the sequence, so they can be in any order with other txs of signers
+	if unorderedTx, ok := tx.(UnorderedTx); ok && unorderedTx.IsUnordered() {
+		return next(ctx, tx, simulate)
+	}
+
 	// increment sequence of all signers
 	for _, addr := range sigTx.GetSigners() {
 		isd.ak.IncAuthSequence(ctx, addr)
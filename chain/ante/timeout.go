@@ -0,0 +1,39 @@
+package ante
+
+import (
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// TxTimeoutDecorator rejects the tx if the block height exceeds its timeout height or the
+// block time is after its expiration. It also runs on ReCheckTx, so txs timeout are removed
+// from the mempool.
+type TxTimeoutDecorator struct{}
+
+func NewTxTimeoutDecorator() TxTimeoutDecorator {
+	return TxTimeoutDecorator{}
+}
+
+func (ttd TxTimeoutDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	timeoutTx, ok := tx.(TimeoutTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid tx type")
+	}
+
+	// the context of CheckTx is of the last block committed, while the tx will be in the next block
+	height := ctx.BlockHeight()
+	if ctx.IsCheckTx() {
+		height++
+	}
+
+	if timeout := timeoutTx.GetTimeoutHeight(); timeout != 0 && uint64(height) > timeout {
+		return ctx, sdkerrors.Wrapf(types.ErrTxTimeoutHeight, "block height %d > timeout height %d", height, timeout)
+	}
+
+	if expiration := timeoutTx.GetExpiration(); expiration != 0 && ctx.BlockTime().Unix() > expiration {
+		return ctx, sdkerrors.Wrapf(types.ErrTxExpired, "block time %d > expiration %d", ctx.BlockTime().Unix(), expiration)
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,78 @@
+package ante_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/KuChainNetwork/kuchain/chain/ante"
+	"github.com/KuChainNetwork/kuchain/chain/client/txutil"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestTxTimeout(t *testing.T) {
+	app, ctx := createAppForTest()
+
+	Convey("test tx timeout height and expiration", t, func() {
+		blockTime := time.Unix(1600000000, 0)
+		ctx := ctx.WithBlockHeight(10).WithBlockTime(blockTime)
+		antehandler := sdk.ChainAnteDecorators(ante.NewTxTimeoutDecorator())
+
+		tx := testStdTx(app, account2)
+
+		// no timeout
+		_, err := antehandler(ctx.WithIsCheckTx(false), tx, false)
+		So(err, ShouldBeNil)
+
+		_, err = antehandler(ctx.WithIsCheckTx(false), tx.WithTimeout(10, 0), false)
+		So(err, ShouldBeNil)
+
+		_, err = antehandler(ctx.WithIsCheckTx(false), tx.WithTimeout(9, 0), false)
+		So(err, simapp.ShouldErrIs, types.ErrTxTimeoutHeight)
+
+		// the tx in CheckTx will be in the next block
+		_, err = antehandler(ctx.WithIsCheckTx(true), tx.WithTimeout(10, 0), false)
+		So(err, simapp.ShouldErrIs, types.ErrTxTimeoutHeight)
+
+		_, err = antehandler(ctx, tx.WithTimeout(0, blockTime.Unix()), false)
+		So(err, ShouldBeNil)
+
+		_, err = antehandler(ctx, tx.WithTimeout(0, blockTime.Unix()-1), false)
+		So(err, simapp.ShouldErrIs, types.ErrTxExpired)
+
+		// also checked on recheck, to remove txs timeout from mempool
+		_, err = antehandler(ctx.WithIsReCheckTx(true), tx.WithTimeout(0, blockTime.Unix()-1), false)
+		So(err, simapp.ShouldErrIs, types.ErrTxExpired)
+
+		So(tx.WithTimeout(0, -1).ValidateBasic(), simapp.ShouldErrIs, types.ErrTxExpired)
+	})
+
+	Convey("test tx timeout in sign bytes", t, func() {
+		tx := testStdTx(app, account2)
+
+		// the sign bytes of txs without timeout are not changed
+		signBytes := txutil.GetSignBytes(ctx, &tx, 1, 1)
+		So(string(signBytes), ShouldNotContainSubstring, "timeout_height")
+		So(string(signBytes), ShouldNotContainSubstring, "expiration")
+
+		timeoutTx := tx.WithTimeout(100, 1600000000)
+		timeoutSignBytes := txutil.GetSignBytes(ctx, &timeoutTx, 1, 1)
+		So(string(timeoutSignBytes), ShouldContainSubstring, `"timeout_height":"100"`)
+		So(string(timeoutSignBytes), ShouldContainSubstring, `"expiration":"1600000000"`)
+
+		// the signatures do not cover the timeout set after signing
+		ak := app.AccountKeeper()
+		antehandler := sdk.ChainAnteDecorators(
+			ante.NewSetPubKeyDecorator(*ak),
+			ante.NewSigVerificationDecorator(*ak))
+
+		_, err := antehandler(ctx, tx, false)
+		So(err, ShouldBeNil)
+
+		_, err = antehandler(ctx, timeoutTx, false)
+		So(err, ShouldNotBeNil)
+	})
+}
@@ -0,0 +1,56 @@
+package ante
+
+import (
+	"github.com/KuChainNetwork/kuchain/chain/constants/keys"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/account/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// UnorderedTxDecorator rejects the replays of unordered txs, which skip the sequence checks. The hash of
+// each unordered tx is kept until its expiration, which cannot be too far from the block time, so the
+// set is bounded and pruned in BeginBlock. It also runs on ReCheckTx, so txs committed are removed
+// from the mempool.
+//
+// CONTRACT: Tx expiration checked by TxTimeoutDecorator and signatures verified before this decorator runs
+type UnorderedTxDecorator struct {
+	ak keeper.AccountKeeper
+}
+
+func NewUnorderedTxDecorator(ak keeper.AccountKeeper) UnorderedTxDecorator {
+	return UnorderedTxDecorator{
+		ak: ak,
+	}
+}
+
+func (utd UnorderedTxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	stdTx, ok := tx.(types.StdTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type for stdTx")
+	}
+
+	if !stdTx.IsUnordered() {
+		return next(ctx, tx, simulate)
+	}
+
+	if maxExpiration := ctx.BlockTime().Unix() + keys.MaxUnorderedTxTTL; stdTx.Expiration > maxExpiration {
+		return ctx, sdkerrors.Wrapf(types.ErrUnorderedTxInvalid,
+			"expiration %d exceeds max ttl %d seconds from block time", stdTx.Expiration, keys.MaxUnorderedTxTTL)
+	}
+
+	hash, err := types.UnorderedTxHash(utd.ak.Cdc(), ctx.ChainID(), stdTx)
+	if err != nil {
+		return ctx, sdkerrors.Wrap(types.ErrUnorderedTxInvalid, err.Error())
+	}
+
+	if utd.ak.HasUnorderedTx(ctx, hash) {
+		return ctx, sdkerrors.Wrapf(types.ErrUnorderedTxDuplicate, "tx %X", hash)
+	}
+
+	if !simulate {
+		utd.ak.AddUnorderedTx(ctx, hash, stdTx.Expiration)
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,92 @@
+package ante_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/KuChainNetwork/kuchain/chain/ante"
+	"github.com/KuChainNetwork/kuchain/chain/constants/keys"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestUnorderedTx(t *testing.T) {
+	app, ctx := createAppForTest()
+
+	Convey("test unordered tx replay protection", t, func() {
+		blockTime := time.Unix(1600000000, 0)
+		ctx := ctx.WithBlockTime(blockTime)
+		ak := app.AccountKeeper()
+
+		antehandler := sdk.ChainAnteDecorators(
+			ante.NewSetPubKeyDecorator(*ak),
+			ante.NewSigVerificationDecorator(*ak),
+			ante.NewUnorderedTxDecorator(*ak),
+			ante.NewIncrementSequenceDecorator(*ak))
+
+		seq, num, err := ak.GetAuthSequence(ctx, addr2)
+		So(err, ShouldBeNil)
+
+		tx := testStdTx(app, account2)
+		signUnordered := func(expiration int64, nonce uint64) types.StdTx {
+			signMsg := types.StdSignMsg{
+				ChainID:       ctx.ChainID(),
+				AccountNumber: num,
+				Fee:           tx.Fee,
+				Msg:           tx.Msgs,
+				Memo:          tx.Memo,
+				Expiration:    expiration,
+				Unordered:     true,
+				Nonce:         nonce,
+			}
+
+			priv := wallet.PrivKey(addr2)
+			sig, err := priv.Sign(signMsg.Bytes())
+			So(err, ShouldBeNil)
+
+			return signMsg.StdTx([]types.StdSignature{{PubKey: priv.PubKey(), Signature: sig}})
+		}
+
+		expiration := blockTime.Unix() + 60
+		unorderedTx := signUnordered(expiration, 1)
+		So(unorderedTx.ValidateBasic(), ShouldBeNil)
+
+		// signed without sequence, and the sequence is not increased
+		_, err = antehandler(ctx, unorderedTx, false)
+		So(err, ShouldBeNil)
+
+		newSeq, _, _ := ak.GetAuthSequence(ctx, addr2)
+		So(newSeq, ShouldEqual, seq)
+
+		// replays are rejected
+		_, err = antehandler(ctx, unorderedTx, false)
+		So(err, simapp.ShouldErrIs, types.ErrUnorderedTxDuplicate)
+
+		// txs with the same content but different nonce are not replays
+		_, err = antehandler(ctx, signUnordered(expiration, 2), false)
+		So(err, ShouldBeNil)
+
+		// the expiration cannot be too far, so the replay-protection set is bounded
+		_, err = antehandler(ctx, signUnordered(blockTime.Unix()+keys.MaxUnorderedTxTTL+1, 1), false)
+		So(err, simapp.ShouldErrIs, types.ErrUnorderedTxInvalid)
+
+		// expiration and nonce are required
+		So(signUnordered(0, 1).ValidateBasic(), simapp.ShouldErrIs, types.ErrUnorderedTxInvalid)
+		So(signUnordered(expiration, 0).ValidateBasic(), simapp.ShouldErrIs, types.ErrUnorderedTxInvalid)
+
+		// the hashes are kept until expired
+		hash, err := types.UnorderedTxHash(ak.Cdc(), ctx.ChainID(), unorderedTx)
+		So(err, ShouldBeNil)
+		ak.PruneUnorderedTxs(ctx.WithBlockTime(time.Unix(expiration, 0)))
+		So(ak.HasUnorderedTx(ctx, hash), ShouldBeTrue)
+
+		ak.PruneUnorderedTxs(ctx.WithBlockTime(time.Unix(expiration+1, 0)))
+		So(ak.HasUnorderedTx(ctx, hash), ShouldBeFalse)
+		hash, err = types.UnorderedTxHash(ak.Cdc(), ctx.ChainID(), signUnordered(expiration, 2))
+		So(err, ShouldBeNil)
+		So(ak.HasUnorderedTx(ctx, hash), ShouldBeFalse)
+	})
+}
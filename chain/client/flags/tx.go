@@ -10,6 +10,11 @@ import (
 func PostCommands(cmds ...*cobra.Command) []*cobra.Command {
 	for _, c := range cmds {
 		c.Flags().String(transaction.FlagPayer, "", "fee payer for tx")
+		c.Flags().Uint64(transaction.FlagTimeoutHeight, 0, "last block height the tx can be included in, 0 for no timeout")
+		c.Flags().String(transaction.FlagExpiration, "", "time after which the tx is rejected, a duration from now such as 10m, a RFC3339 time or an unix time")
+		c.Flags().Bool(transaction.FlagUnordered, false, "build an unordered tx without sequence, which requires the expiration within 10m")
+		c.Flags().Uint64(transaction.FlagNonce, 0, "nonce of the unordered tx, random if 0")
+		c.Flags().String(transaction.FlagSignMode, "direct", "sign mode, direct or textual, in which the msg data is decoded in the bytes signed for hardware wallets")
 	}
 
 	return cosmosFlags.PostCommands(cmds...)
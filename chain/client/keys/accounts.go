@@ -0,0 +1,105 @@
+package keys
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// accountsFileName the file in the client home recording the accounts controlled by each key
+const accountsFileName = "key_accounts.json"
+
+// KeyAccounts the names of accounts controlled by a key, which use the address of the key as auth
+type KeyAccounts struct {
+	Name     string         `json:"name" yaml:"name"`
+	Address  sdk.AccAddress `json:"address" yaml:"address"`
+	Accounts []string       `json:"accounts" yaml:"accounts"`
+}
+
+// AccountsStore records the accounts controlled by the keys in keybase, synced from the chain by
+// `keys accounts sync`, so tx commands can select the key to sign by the account name.
+type AccountsStore struct {
+	path string
+	keys map[string]KeyAccounts
+}
+
+// LoadAccountsStore loads the accounts recorded in the client home, which is empty if not synced
+func LoadAccountsStore(home string) (*AccountsStore, error) {
+	store := &AccountsStore{
+		path: filepath.Join(home, accountsFileName),
+		keys: make(map[string]KeyAccounts),
+	}
+
+	bz, err := ioutil.ReadFile(store.path)
+	if os.IsNotExist(err) {
+		return store, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []KeyAccounts
+	if err := json.Unmarshal(bz, &keys); err != nil {
+		return nil, err
+	}
+
+	for _, key := range keys {
+		store.keys[key.Name] = key
+	}
+
+	return store, nil
+}
+
+// Set records the accounts controlled by the key
+func (s *AccountsStore) Set(key KeyAccounts) {
+	s.keys[key.Name] = key
+}
+
+// Delete removes the record of the key
+func (s *AccountsStore) Delete(name string) {
+	delete(s.keys, name)
+}
+
+// List returns the records of keys in order of key names
+func (s *AccountsStore) List() []KeyAccounts {
+	res := make([]KeyAccounts, 0, len(s.keys))
+	for _, key := range s.keys {
+		res = append(res, key)
+	}
+
+	sort.Slice(res, func(i, j int) bool { return res[i].Name < res[j].Name })
+
+	return res
+}
+
+// KeyOfAccount returns the key controlling the account, false if not recorded
+func (s *AccountsStore) KeyOfAccount(account string) (KeyAccounts, bool) {
+	for _, key := range s.List() {
+		for _, acc := range key.Accounts {
+			if acc == account {
+				return key, true
+			}
+		}
+	}
+
+	return KeyAccounts{}, false
+}
+
+// Save writes the records to the client home
+func (s *AccountsStore) Save() error {
+	bz, err := json.MarshalIndent(s.List(), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0700); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(s.path, bz, 0600)
+}
@@ -0,0 +1,110 @@
+package keys
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	accountTypes "github.com/KuChainNetwork/kuchain/x/account/types"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AccountsCommand returns the commands to record the accounts controlled by the keys
+func AccountsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accounts",
+		Short: "Record the accounts controlled by local keys",
+		Long: `Record the names of accounts which use the local keys as auth, queried from a full node.
+
+The tx commands sign by the key controlling the account if no key is given by --from.`,
+	}
+
+	cmd.AddCommand(
+		accountsSyncCommand(),
+		accountsListCommand(),
+	)
+
+	return cmd
+}
+
+func accountsSyncCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sync [name...]",
+		Short: "Query the accounts controlled by the keys of [name...], or all keys if no name given",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			kb, err := keys.NewKeyring(sdk.KeyringServiceName(), viper.GetString(flags.FlagKeyringBackend), viper.GetString(flags.FlagHome), inBuf)
+			if err != nil {
+				return err
+			}
+
+			var infos []keys.Info
+			if len(args) == 0 {
+				if infos, err = kb.List(); err != nil {
+					return err
+				}
+			} else {
+				for _, name := range args {
+					info, err := kb.Get(name)
+					if err != nil {
+						return err
+					}
+					infos = append(infos, info)
+				}
+			}
+
+			store, err := LoadAccountsStore(viper.GetString(flags.FlagHome))
+			if err != nil {
+				return err
+			}
+
+			retriever := accountTypes.NewAccountRetriever(context.NewCLIContextWithInput(inBuf))
+			for _, info := range infos {
+				accounts, err := retriever.GetAccountsByAuth(info.GetAddress())
+				if err != nil {
+					return fmt.Errorf("query accounts of key %s: %w", info.GetName(), err)
+				}
+
+				store.Set(KeyAccounts{Name: info.GetName(), Address: info.GetAddress(), Accounts: accounts})
+			}
+
+			if err := store.Save(); err != nil {
+				return err
+			}
+
+			printKeyAccounts(cmd, store.List())
+			return nil
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
+func accountsListCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List the accounts recorded for the keys",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store, err := LoadAccountsStore(viper.GetString(flags.FlagHome))
+			if err != nil {
+				return err
+			}
+
+			printKeyAccounts(cmd, store.List())
+			return nil
+		},
+	}
+}
+
+func printKeyAccounts(cmd *cobra.Command, keys []KeyAccounts) {
+	for _, key := range keys {
+		fmt.Fprintf(cmd.OutOrStdout(), "%s\t%s\t%s\n", key.Name, key.Address, strings.Join(key.Accounts, ","))
+	}
+}
@@ -0,0 +1,67 @@
+package keys_test
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/keys"
+	"github.com/KuChainNetwork/kuchain/chain/client/txutil"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestAccountsStore(t *testing.T) {
+	Convey("test accounts recorded for keys", t, func() {
+		home, err := ioutil.TempDir("", "kucli")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(home)
+
+		var (
+			aliceAddr = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+			bobAddr   = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+		)
+
+		store, err := keys.LoadAccountsStore(home)
+		So(err, ShouldBeNil)
+		So(store.List(), ShouldBeEmpty)
+
+		store.Set(keys.KeyAccounts{Name: "bob", Address: bobAddr, Accounts: []string{"bob", "bobshop"}})
+		store.Set(keys.KeyAccounts{Name: "alice", Address: aliceAddr, Accounts: []string{"alice"}})
+		So(store.Save(), ShouldBeNil)
+
+		store, err = keys.LoadAccountsStore(home)
+		So(err, ShouldBeNil)
+		So(store.List(), ShouldHaveLength, 2)
+		So(store.List()[0].Name, ShouldEqual, "alice")
+
+		key, ok := store.KeyOfAccount("bobshop")
+		So(ok, ShouldBeTrue)
+		So(key.Name, ShouldEqual, "bob")
+		So(key.Address, ShouldResemble, bobAddr)
+
+		_, ok = store.KeyOfAccount("carol")
+		So(ok, ShouldBeFalse)
+
+		// tx commands select the key by the account if no --from
+		viper.Set(flags.FlagHome, home)
+		defer viper.Set(flags.FlagHome, "")
+
+		ctx := txutil.NewKuCLICtxNoFrom(context.CLIContext{}).WithFromAccount(types.NewAccountIDFromName(types.MustName("bobshop")))
+		So(ctx.GetFromName(), ShouldEqual, "bob")
+		So(ctx.GetFromAddress(), ShouldResemble, bobAddr)
+
+		// the key given by --from is not changed
+		ctx = txutil.NewKuCLICtxNoFrom(context.CLIContext{}.WithFromName("alice")).WithAccount(types.MustName("bobshop"))
+		So(ctx.GetFromName(), ShouldEqual, "alice")
+
+		ctx = txutil.NewKuCLICtxNoFrom(context.CLIContext{}).WithAccount(types.MustName("carol"))
+		So(ctx.GetFromName(), ShouldEqual, "")
+	})
+}
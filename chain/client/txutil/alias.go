@@ -4,6 +4,7 @@ import (
 	"github.com/KuChainNetwork/kuchain/chain/transaction"
 	"github.com/KuChainNetwork/kuchain/chain/types"
 	accountTypes "github.com/KuChainNetwork/kuchain/x/account/types"
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -33,5 +34,11 @@ func GetSignBytes(ctx sdk.Context, tx *StdTx, accNum, seq uint64) []byte {
 
 	return types.StdSignBytes(
 		chainID, accNum, seq, tx.Fee, tx.Msgs, tx.Memo,
+		tx.Options(),
 	)
 }
+
+// GetSignBytesByMode returns the signBytes of the tx for a given signer in the sign mode
+func GetSignBytesByMode(ctx sdk.Context, cdc *codec.Codec, tx *StdTx, mode types.SignMode, accNum, seq uint64) ([]byte, error) {
+	return types.StdSignBytesByMode(cdc, mode, ctx.ChainID(), accNum, seq, tx.Fee, tx.Msgs, tx.Memo, tx.Options())
+}
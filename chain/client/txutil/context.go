@@ -3,10 +3,13 @@ package txutil
 import (
 	"io"
 
+	"github.com/KuChainNetwork/kuchain/chain/client/keys"
 	"github.com/KuChainNetwork/kuchain/chain/types"
 	"github.com/KuChainNetwork/kuchain/x/account/exported"
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/viper"
 )
 
 // KuCLIContext cli context for kuchain, add account info
@@ -39,15 +42,34 @@ func NewKuCLICtxByBufNoFrom(cdc *codec.Codec, inBuf io.Reader) KuCLIContext {
 	return NewKuCLICtxNoFrom(ctx)
 }
 
-// WithFromAccount with account from accountID
+// WithFromAccount with account from accountID, if no key is given by --from,
+// the key recorded to control the account is used to sign.
 func (k KuCLIContext) WithFromAccount(from AccountID) KuCLIContext {
 	k.FromAccount = from
+
+	if name, ok := from.ToName(); ok && k.FromName == "" {
+		k = k.withKeyOfAccount(name)
+	}
+
 	return k
 }
 
 // WithAccount with account name
 func (k KuCLIContext) WithAccount(name Name) KuCLIContext {
-	k.FromAccount = types.NewAccountIDFromName(name)
+	return k.WithFromAccount(types.NewAccountIDFromName(name))
+}
+
+// withKeyOfAccount sets the from key by the key controlling the account recorded by `keys accounts sync`
+func (k KuCLIContext) withKeyOfAccount(name Name) KuCLIContext {
+	store, err := keys.LoadAccountsStore(viper.GetString(flags.FlagHome))
+	if err != nil {
+		return k
+	}
+
+	if key, ok := store.KeyOfAccount(name.String()); ok {
+		k.CLIContext = k.CLIContext.WithFromName(key.Name).WithFromAddress(key.Address)
+	}
+
 	return k
 }
 
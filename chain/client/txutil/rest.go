@@ -53,7 +53,7 @@ func WriteGenerateStdTxResponse(w http.ResponseWriter, cliCtx KuCLIContext, br r
 		return
 	}
 
-	output, err := cliCtx.Codec.MarshalJSON(NewStdTx(stdMsg.Msg, stdMsg.Fee, nil, stdMsg.Memo))
+	output, err := cliCtx.Codec.MarshalJSON(stdMsg.StdTx(nil))
 	if err != nil {
 		rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
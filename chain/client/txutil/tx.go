@@ -193,7 +193,7 @@ func SignStdTx(
 		}
 	}
 
-	return txBldr.SignStdTx(name, keys.DefaultKeyPass, stdTx, appendSig)
+	return txBldr.WithCodec(cliCtx.Codec).SignStdTx(name, keys.DefaultKeyPass, stdTx, appendSig)
 }
 
 // SignStdTxWithSignerAddress attaches a signature to a StdTx and returns a copy of a it.
@@ -217,7 +217,7 @@ func SignStdTxWithSignerAddress(
 		}
 	}
 
-	return txBldr.SignStdTx(name, keys.DefaultKeyPass, stdTx, false)
+	return txBldr.WithCodec(cliCtx.Codec).SignStdTx(name, keys.DefaultKeyPass, stdTx, false)
 }
 
 // Read and decode a StdTx from the given filename.  Can pass "-" to read from stdin.
@@ -291,6 +291,7 @@ func parseQueryResponse(cdc *codec.Codec, rawRes []byte) (uint64, error) {
 
 // PrepareTxBuilder populates a TxBuilder in preparation for the build of a Tx.
 func PrepareTxBuilder(txBldr TxBuilder, cliCtx KuCLIContext) (TxBuilder, error) {
+	txBldr = txBldr.WithCodec(cliCtx.Codec)
 	from := cliCtx.GetAccountID()
 
 	accGetter := NewAccountRetriever(cliCtx)
@@ -372,7 +373,7 @@ func buildUnsignedStdTxOffline(txBldr TxBuilder, cliCtx KuCLIContext, msgs []sdk
 		return stdTx, err
 	}
 
-	return NewStdTx(stdSignMsg.Msg, stdSignMsg.Fee, nil, stdSignMsg.Memo), nil
+	return stdSignMsg.StdTx(nil), nil
 }
 
 func isTxSigner(user sdk.AccAddress, signers []sdk.AccAddress) bool {
@@ -28,6 +28,12 @@ var (
 	EstimatedGasSetWithdrawAddr uint64 = 40000
 )
 
+// base gas cost of msgs in the default gas schedule, charged in ante besides the gas of store and tx size
+var (
+	GasMsgCreateAccount uint64 = 20000
+	GasMsgCreateCoin    uint64 = 40000
+)
+
 var (
 	MinGasPrice types.DecCoins
 )
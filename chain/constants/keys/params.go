@@ -7,4 +7,5 @@ const (
 	DefaultTxSizeCostPerByte      uint64 = 10
 	DefaultSigVerifyCostED25519   uint64 = 590
 	DefaultSigVerifyCostSecp256k1 uint64 = 1000
+	MaxUnorderedTxTTL             int64  = 600 // max seconds from block time to the expiration of unordered txs
 )
@@ -95,18 +95,35 @@ func makeMultiSignCmd(cdc *codec.Codec) func(cmd *cobra.Command, args []string)
 			txBldr = txBldr.WithAccountNumber(accnum).WithSequence(seq)
 		}
 
-		// read each signature and add it to the multisig if valid
+		// read each signature and add it to the multisig if valid, the multisig verifies all
+		// signatures by the same bytes, so they should be in the same sign mode
+		var signMode types.SignMode
 		for i := 2; i < len(args); i++ {
 			stdSig, err := readAndUnmarshalStdSignature(cdc, args[i])
 			if err != nil {
 				return err
 			}
 
-			// Validate each signature
-			sigBytes := types.StdSignBytes(
-				txBldr.ChainID(), txBldr.AccountNumber(), txBldr.Sequence(),
+			if i == 2 {
+				signMode = stdSig.SignMode
+			} else if stdSig.SignMode != signMode {
+				return fmt.Errorf("signatures in different sign modes: %s, %s", signMode, stdSig.SignMode)
+			}
+
+			// Validate each signature, unordered txs are signed without sequence
+			seq := txBldr.Sequence()
+			if stdTx.IsUnordered() {
+				seq = 0
+			}
+
+			sigBytes, err := types.StdSignBytesByMode(
+				cdc, signMode, txBldr.ChainID(), txBldr.AccountNumber(), seq,
 				stdTx.Fee, stdTx.GetMsgs(), stdTx.GetMemo(),
+				stdTx.Options(),
 			)
+			if err != nil {
+				return err
+			}
 			if ok := stdSig.PubKey.VerifyBytes(sigBytes, stdSig.Signature); !ok {
 				return fmt.Errorf("couldn't verify signature")
 			}
@@ -115,8 +132,9 @@ func makeMultiSignCmd(cdc *codec.Codec) func(cmd *cobra.Command, args []string)
 			}
 		}
 
-		newStdSig := types.StdSignature{Signature: cdc.MustMarshalBinaryBare(multisigSig), PubKey: multisigPub}
-		newTx := types.NewStdTx(stdTx.GetMsgs(), stdTx.Fee, []types.StdSignature{newStdSig}, stdTx.GetMemo())
+		newStdSig := types.StdSignature{Signature: cdc.MustMarshalBinaryBare(multisigSig), PubKey: multisigPub, SignMode: signMode}
+		newTx := types.NewStdTx(stdTx.GetMsgs(), stdTx.Fee, []types.StdSignature{newStdSig}, stdTx.GetMemo()).
+			WithOptions(stdTx.Options())
 
 		sigOnly := viper.GetBool(flagSigOnly)
 		var json []byte
@@ -0,0 +1,263 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	chainFlags "github.com/KuChainNetwork/kuchain/chain/client/flags"
+	"github.com/KuChainNetwork/kuchain/chain/client/txutil"
+	"github.com/KuChainNetwork/kuchain/chain/transaction"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// GetPartialCommand returns the commands to collect the signatures of a tx from several parties
+func GetPartialCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "partial",
+		Short: "Collect signatures of a transaction from several parties offline",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Collect signatures of transactions which require several signers, such as
+msgs with several auth addresses or transfers from several accounts.
+
+A bundle file is created from a transaction generated with the --generate-only flag, which lists
+the signers required with their account numbers and sequences queried from a full node. Each party
+signs the bundle offline or appends a signature generated by 'sign --signature-only', then the
+transaction is broadcast after all signers signed.
+
+Example:
+$ %[1]s tx partial create tx.json --output-document bundle.json
+$ %[1]s tx partial sign bundle.json --from alice
+$ %[1]s tx partial append bundle.json bob-sig.json
+$ %[1]s tx partial status bundle.json
+$ %[1]s tx partial broadcast bundle.json
+`,
+				version.ClientName,
+			),
+		),
+	}
+
+	cmd.AddCommand(
+		getPartialCreateCommand(cdc),
+		getPartialSignCommand(cdc),
+		getPartialAppendCommand(cdc),
+		getPartialStatusCommand(cdc),
+		getPartialBroadcastCommand(cdc),
+	)
+
+	return cmd
+}
+
+func getPartialCreateCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create [file]",
+		Short: "Create a bundle of the transaction in [file] listing the signers required",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			stdTx, err := txutil.ReadStdTxFromFile(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := txutil.NewKuCLICtxByBufNoFrom(cdc, inBuf)
+
+			chainID := viper.GetString(flags.FlagChainID)
+			if chainID == "" {
+				return fmt.Errorf("chain ID required but not specified")
+			}
+
+			partial := transaction.NewPartialTx(chainID, stdTx)
+			for _, signer := range partial.Signers {
+				num, seq, err := txutil.NewAccountRetriever(cliCtx).GetAuthNumberSequence(types.NewAccountIDFromAccAdd(signer.Address))
+				if err != nil {
+					return err
+				}
+
+				if err := partial.SetAuthNumberSequence(signer.Address, num, seq); err != nil {
+					return err
+				}
+			}
+
+			return writePartialTx(cdc, viper.GetString(flagOutfile), partial)
+		},
+	}
+
+	cmd.Flags().String(flagOutfile, "", "The bundle will be written to the given file instead of STDOUT")
+
+	return flags.GetCommands(cmd)[0]
+}
+
+func getPartialSignCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign [bundle]",
+		Short: "Sign the transaction in [bundle] by the key of --from, and write the signature back to [bundle]",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			partial, err := readPartialTx(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithCodec(cdc)
+
+			info, err := txBldr.Keybase().Get(viper.GetString(flags.FlagFrom))
+			if err != nil {
+				return err
+			}
+
+			msg, err := partial.SignMsg(info.GetAddress())
+			if err != nil {
+				return err
+			}
+
+			sig, err := transaction.MakeSignatureWithMode(txBldr.Keybase(), info.GetName(), keys.DefaultKeyPass, cdc, txBldr.SignMode(), msg)
+			if err != nil {
+				return err
+			}
+
+			if err := partial.AddSignature(cdc, sig); err != nil {
+				return err
+			}
+
+			return writePartialTx(cdc, args[0], partial)
+		},
+	}
+
+	// with the flags of kuchain, such as the sign mode
+	cmd = chainFlags.PostCommands(cmd)[0]
+	cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+func getPartialAppendCommand(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "append [bundle] [[signature]...]",
+		Short: "Verify and append the signatures generated by 'sign --signature-only' to [bundle]",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			partial, err := readPartialTx(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			for _, file := range args[1:] {
+				sig, err := readAndUnmarshalStdSignature(cdc, file)
+				if err != nil {
+					return err
+				}
+
+				if err := partial.AddSignature(cdc, sig); err != nil {
+					return fmt.Errorf("append signature in %s: %w", file, err)
+				}
+			}
+
+			return writePartialTx(cdc, args[0], partial)
+		},
+	}
+}
+
+func getPartialStatusCommand(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "status [bundle]",
+		Short: "Print the signers of [bundle] who have signed and who are still missing",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			partial, err := readPartialTx(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			fmt.Fprintf(out, "Chain ID: %s\n\nSigners:\n", partial.ChainID)
+
+			for i, signer := range partial.Signers {
+				status := "MISSING"
+				if signer.Signed() {
+					status = fmt.Sprintf("SIGNED (%s)", signer.Signature.SignMode)
+				}
+
+				fmt.Fprintf(out, "  %d: %s\t[account number: %d, sequence: %d]\t%s\n",
+					i, signer.Address, signer.AccountNumber, signer.Sequence, status)
+			}
+
+			missing := partial.Missing()
+			fmt.Fprintf(out, "\n%d of %d signed, %d missing\n", len(partial.Signers)-len(missing), len(partial.Signers), len(missing))
+
+			return nil
+		},
+	}
+}
+
+func getPartialBroadcastCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "broadcast [bundle]",
+		Short: "Broadcast the transaction in [bundle] signed by all signers",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			partial, err := readPartialTx(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			stdTx, err := partial.SignedTx()
+			if err != nil {
+				return err
+			}
+
+			cliCtx := txutil.NewKuCLICtxByBufNoFrom(cdc, bufio.NewReader(cmd.InOrStdin()))
+			if cliCtx.ChainID != "" && cliCtx.ChainID != partial.ChainID {
+				return fmt.Errorf("chain id of bundle %s is not %s", partial.ChainID, cliCtx.ChainID)
+			}
+
+			txBytes, err := txutil.GetTxEncoder(cdc)(stdTx)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.BroadcastTx(txBytes)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(res)
+		},
+	}
+
+	return flags.PostCommands(cmd)[0]
+}
+
+func readPartialTx(cdc *codec.Codec, filename string) (partial transaction.PartialTx, err error) {
+	var bytes []byte
+	if bytes, err = ioutil.ReadFile(filename); err != nil {
+		return
+	}
+
+	err = cdc.UnmarshalJSON(bytes, &partial)
+	return
+}
+
+// writePartialTx writes the bundle to the file, or STDOUT if filename is empty
+func writePartialTx(cdc *codec.Codec, filename string, partial transaction.PartialTx) error {
+	json, err := cdc.MarshalJSONIndent(partial, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if filename == "" {
+		fmt.Printf("%s\n", json)
+		return nil
+	}
+
+	return ioutil.WriteFile(filename, append(json, '\n'), 0644)
+}
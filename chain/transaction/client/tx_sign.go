@@ -233,12 +233,21 @@ func printAndValidateSigs(
 				return false
 			}
 
-			sigBytes := types.StdSignBytes(
-				chainID, num, seq,
+			// unordered txs are signed without sequence
+			if stdTx.IsUnordered() {
+				seq = 0
+			}
+
+			sigBytes, err := types.StdSignBytesByMode(
+				cliCtx.Codec, sig.SignMode, chainID, num, seq,
 				stdTx.Fee, stdTx.GetMsgs(), stdTx.GetMemo(),
+				stdTx.Options(),
 			)
 
-			if ok := sig.VerifyBytes(sigBytes, sig.Signature); !ok {
+			if err != nil {
+				sigSanity = fmt.Sprintf("ERROR: %s", err)
+				success = false
+			} else if ok := sig.VerifyBytes(sigBytes, sig.Signature); !ok {
 				sigSanity = "ERROR: signature invalid"
 				success = false
 			}
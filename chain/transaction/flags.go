@@ -1,5 +1,41 @@
 package transaction
 
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
 const (
-	FlagPayer = "fee-payer"
+	FlagPayer         = "fee-payer"
+	FlagTimeoutHeight = "timeout-height"
+	FlagExpiration    = "expiration"
+	FlagUnordered     = "unordered"
+	FlagNonce         = "nonce"
+	FlagSignMode      = "sign-mode"
 )
+
+// ParseExpiration parses the expiration of tx, which is a duration from now such as "10m",
+// a RFC3339 time or an unix time in seconds, returns 0 for never expire if empty.
+func ParseExpiration(str string, now time.Time) (int64, error) {
+	if str == "" {
+		return 0, nil
+	}
+
+	if d, err := time.ParseDuration(str); err == nil {
+		if d <= 0 {
+			return 0, fmt.Errorf("expiration duration should be positive: %s", str)
+		}
+		return now.Add(d).Unix(), nil
+	}
+
+	if t, err := time.Parse(time.RFC3339, str); err == nil {
+		return t.Unix(), nil
+	}
+
+	if sec, err := strconv.ParseInt(str, 10, 64); err == nil && sec > 0 {
+		return sec, nil
+	}
+
+	return 0, fmt.Errorf("invalid expiration %q, should be a duration, a RFC3339 time or an unix time", str)
+}
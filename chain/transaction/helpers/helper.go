@@ -32,7 +32,7 @@ func GenTx(msgs []sdk.Msg, feeAmt types.Coins, gas uint64, chainID string, accnu
 
 	for i, p := range priv {
 		// use a empty chainID for ease of testing
-		sig, err := p.Sign(types.StdSignBytes(chainID, accnums[i], seq[i], fee, msgs, memo))
+		sig, err := p.Sign(types.StdSignBytes(chainID, accnums[i], seq[i], fee, msgs, memo, types.TxOptions{}))
 		if err != nil {
 			panic(err)
 		}
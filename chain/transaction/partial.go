@@ -0,0 +1,156 @@
+package transaction
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// PartialSigner a signer required by the tx, with the account number and sequence to sign by
+type PartialSigner struct {
+	Address       types.AccAddress `json:"address" yaml:"address"`
+	AccountNumber uint64           `json:"account_number" yaml:"account_number"`
+	Sequence      uint64           `json:"sequence" yaml:"sequence"`
+	Signature     *StdSignature    `json:"signature,omitempty" yaml:"signature"`
+}
+
+// Signed returns if the signer has signed
+func (s PartialSigner) Signed() bool { return s.Signature != nil }
+
+// PartialTx is a bundle to collect the signatures of a tx from several parties offline, the signers are
+// in the order of GetSigners of the tx, each of them appends its signature, the tx can be broadcast
+// after all signers signed.
+type PartialTx struct {
+	ChainID string          `json:"chain_id" yaml:"chain_id"`
+	Tx      StdTx           `json:"tx" yaml:"tx"`
+	Signers []PartialSigner `json:"signers" yaml:"signers"`
+}
+
+// NewPartialTx creates a bundle for the tx with the signers required, the signatures in the tx are dropped
+func NewPartialTx(chainID string, tx StdTx) PartialTx {
+	signers := tx.GetSigners()
+
+	res := PartialTx{
+		ChainID: chainID,
+		Tx:      NewStdTx(tx.GetMsgs(), tx.Fee, nil, tx.GetMemo()).WithOptions(tx.Options()),
+		Signers: make([]PartialSigner, 0, len(signers)),
+	}
+
+	for _, signer := range signers {
+		res.Signers = append(res.Signers, PartialSigner{Address: signer})
+	}
+
+	return res
+}
+
+// signerIndex returns the index of the signer, -1 if not a signer
+func (p PartialTx) signerIndex(addr types.AccAddress) int {
+	for i, signer := range p.Signers {
+		if signer.Address.Equals(addr) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// SetAuthNumberSequence sets the account number and sequence of the signer to sign by
+func (p *PartialTx) SetAuthNumberSequence(addr types.AccAddress, accountNumber, sequence uint64) error {
+	idx := p.signerIndex(addr)
+	if idx < 0 {
+		return fmt.Errorf("%s is not a signer of the tx", addr)
+	}
+
+	p.Signers[idx].AccountNumber = accountNumber
+	p.Signers[idx].Sequence = sequence
+
+	return nil
+}
+
+// SignMsg returns the msg to sign by the signer
+func (p PartialTx) SignMsg(addr types.AccAddress) (StdSignMsg, error) {
+	idx := p.signerIndex(addr)
+	if idx < 0 {
+		return StdSignMsg{}, fmt.Errorf("%s is not a signer of the tx", addr)
+	}
+
+	// unordered txs are signed without sequence
+	sequence := p.Signers[idx].Sequence
+	if p.Tx.IsUnordered() {
+		sequence = 0
+	}
+
+	return StdSignMsg{
+		ChainID:       p.ChainID,
+		AccountNumber: p.Signers[idx].AccountNumber,
+		Sequence:      sequence,
+		Fee:           p.Tx.Fee,
+		Msg:           p.Tx.GetMsgs(),
+		Memo:          p.Tx.GetMemo(),
+		TimeoutHeight: p.Tx.TimeoutHeight,
+		Expiration:    p.Tx.Expiration,
+		Unordered:     p.Tx.Unordered,
+		Nonce:         p.Tx.Nonce,
+	}, nil
+}
+
+// AddSignature verifies the signature by the signer of its public key and adds it,
+// the codec is used to decode the msg data for signatures in textual sign mode.
+func (p *PartialTx) AddSignature(cdc *codec.Codec, sig StdSignature) error {
+	if sig.PubKey == nil {
+		return fmt.Errorf("public key of the signature is required")
+	}
+
+	addr := types.AccAddress(sig.PubKey.Address())
+
+	msg, err := p.SignMsg(addr)
+	if err != nil {
+		return err
+	}
+
+	signBytes, err := msg.SignBytes(cdc, sig.SignMode)
+	if err != nil {
+		return err
+	}
+
+	if !sig.PubKey.VerifyBytes(signBytes, sig.Signature) {
+		return fmt.Errorf("signature of %s is invalid, check the chain id, account number and sequence", addr)
+	}
+
+	p.Signers[p.signerIndex(addr)].Signature = &sig
+
+	return nil
+}
+
+// Missing returns the signers who have not signed
+func (p PartialTx) Missing() []types.AccAddress {
+	var res []types.AccAddress
+	for _, signer := range p.Signers {
+		if !signer.Signed() {
+			res = append(res, signer.Address)
+		}
+	}
+
+	return res
+}
+
+// SignedTx returns the tx with the signatures of all signers
+func (p PartialTx) SignedTx() (StdTx, error) {
+	if missing := p.Missing(); len(missing) > 0 {
+		addrs := make([]string, 0, len(missing))
+		for _, addr := range missing {
+			addrs = append(addrs, addr.String())
+		}
+
+		return StdTx{}, fmt.Errorf("signatures missing from %s", strings.Join(addrs, ", "))
+	}
+
+	sigs := make([]StdSignature, 0, len(p.Signers))
+	for _, signer := range p.Signers {
+		sigs = append(sigs, *signer.Signature)
+	}
+
+	return NewStdTx(p.Tx.GetMsgs(), p.Tx.Fee, sigs, p.Tx.GetMemo()).WithOptions(p.Tx.Options()), nil
+}
@@ -0,0 +1,67 @@
+package transaction_test
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/KuChainNetwork/kuchain/chain/msg"
+	"github.com/KuChainNetwork/kuchain/chain/transaction"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// testMsg a msg requires the signatures of all auths
+type testMsg struct {
+	types.KuMsg
+}
+
+func (msg testMsg) ValidateBasic() error { return msg.ValidateTransfer() }
+
+func TestPartialTx(t *testing.T) {
+	Convey("test collect signatures of partial tx", t, func() {
+		privs := []crypto.PrivKey{secp256k1.GenPrivKey(), secp256k1.GenPrivKey()}
+		addrs := []types.AccAddress{types.AccAddress(privs[0].PubKey().Address()), types.AccAddress(privs[1].PubKey().Address())}
+
+		kuMsg := testMsg{*msg.MustNewKuMsg(types.MustName("test"), msg.WithAuths(addrs))}
+		tx := types.NewStdTx([]sdk.Msg{kuMsg}, types.NewStdFee(100000, types.AccountID{}, nil), nil, "memo")
+
+		partial := transaction.NewPartialTx("testing", tx)
+		So(partial.Missing(), ShouldResemble, addrs)
+		So(partial.SetAuthNumberSequence(addrs[0], 1, 10), ShouldBeNil)
+		So(partial.SetAuthNumberSequence(addrs[1], 2, 20), ShouldBeNil)
+		So(partial.SetAuthNumberSequence(types.AccAddress(secp256k1.GenPrivKey().PubKey().Address()), 3, 30), ShouldNotBeNil)
+
+		sign := func(priv crypto.PrivKey, accnum, seq uint64) types.StdSignature {
+			signBytes := types.StdSignBytes("testing", accnum, seq, tx.Fee, tx.Msgs, tx.Memo, tx.Options())
+			sig, err := priv.Sign(signBytes)
+			So(err, ShouldBeNil)
+
+			return types.StdSignature{PubKey: priv.PubKey(), Signature: sig}
+		}
+
+		// signatures by wrong sequence or of not signers are rejected
+		So(partial.AddSignature(nil, sign(privs[1], 2, 21)), ShouldNotBeNil)
+		So(partial.AddSignature(nil, sign(secp256k1.GenPrivKey(), 2, 20)), ShouldNotBeNil)
+
+		// signers sign in any order
+		So(partial.AddSignature(nil, sign(privs[1], 2, 20)), ShouldBeNil)
+		So(partial.Missing(), ShouldResemble, []types.AccAddress{addrs[0]})
+
+		_, err := partial.SignedTx()
+		So(err, ShouldNotBeNil)
+
+		So(partial.AddSignature(nil, sign(privs[0], 1, 10)), ShouldBeNil)
+		So(partial.Missing(), ShouldBeEmpty)
+
+		// the signatures are in the order of signers
+		signedTx, err := partial.SignedTx()
+		So(err, ShouldBeNil)
+		So(signedTx.GetSignatures(), ShouldHaveLength, 2)
+		So(signedTx.GetSignatures()[0].PubKey, ShouldResemble, privs[0].PubKey())
+		So(signedTx.GetSignatures()[1].PubKey, ShouldResemble, privs[1].PubKey())
+		So(signedTx.Memo, ShouldEqual, "memo")
+	})
+}
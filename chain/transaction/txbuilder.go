@@ -1,15 +1,19 @@
 package transaction
 
 import (
+	"crypto/rand"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"io"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/KuChainNetwork/kuchain/chain/constants"
 	"github.com/KuChainNetwork/kuchain/chain/types"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keys"
 	crkeys "github.com/cosmos/cosmos-sdk/crypto/keys"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -30,6 +34,12 @@ type TxBuilder struct {
 	fees               Coins
 	gasPrices          DecCoins
 	payer              string
+	timeoutHeight      uint64
+	expiration         int64
+	unordered          bool
+	nonce              uint64
+	signMode           types.SignMode
+	cdc                *codec.Codec
 }
 
 // NewTxBuilder returns a new initialized TxBuilder.
@@ -74,6 +84,21 @@ func NewTxBuilderFromCLI(input io.Reader) TxBuilder {
 	txbldr = txbldr.WithFees(viper.GetString(flags.FlagFees))
 	txbldr = txbldr.WithGasPrices(viper.GetString(flags.FlagGasPrices))
 	txbldr = txbldr.WithPayer(viper.GetString(FlagPayer))
+	txbldr = txbldr.WithTimeoutHeight(viper.GetUint64(FlagTimeoutHeight))
+
+	expiration, err := ParseExpiration(viper.GetString(FlagExpiration), time.Now())
+	if err != nil {
+		panic(err)
+	}
+	txbldr = txbldr.WithExpiration(expiration)
+	txbldr = txbldr.WithUnordered(viper.GetBool(FlagUnordered))
+	txbldr = txbldr.WithNonce(viper.GetUint64(FlagNonce))
+
+	signMode, err := types.ParseSignMode(viper.GetString(FlagSignMode))
+	if err != nil {
+		panic(err)
+	}
+	txbldr = txbldr.WithSignMode(signMode)
 
 	return txbldr
 }
@@ -124,6 +149,21 @@ func (bldr TxBuilder) FeePayer() types.AccountID {
 	return res
 }
 
+// TimeoutHeight returns the last block height the tx can be included in, 0 for no timeout
+func (bldr TxBuilder) TimeoutHeight() uint64 { return bldr.timeoutHeight }
+
+// Expiration returns the unix time in seconds after which the tx is rejected, 0 for never expire
+func (bldr TxBuilder) Expiration() int64 { return bldr.expiration }
+
+// Unordered returns if to build unordered txs, which are signed without sequence
+func (bldr TxBuilder) Unordered() bool { return bldr.unordered }
+
+// Nonce returns the nonce of unordered txs, a random one is used if 0
+func (bldr TxBuilder) Nonce() uint64 { return bldr.nonce }
+
+// SignMode returns the sign mode of the signatures
+func (bldr TxBuilder) SignMode() types.SignMode { return bldr.signMode }
+
 // WithTxEncoder returns a copy of the context with an updated codec.
 func (bldr TxBuilder) WithTxEncoder(txEncoder sdk.TxEncoder) TxBuilder {
 	bldr.txEncoder = txEncoder
@@ -192,6 +232,42 @@ func (bldr TxBuilder) WithMemo(memo string) TxBuilder {
 	return bldr
 }
 
+// WithTimeoutHeight returns a copy of the context with an updated timeout height.
+func (bldr TxBuilder) WithTimeoutHeight(height uint64) TxBuilder {
+	bldr.timeoutHeight = height
+	return bldr
+}
+
+// WithExpiration returns a copy of the context with an updated expiration.
+func (bldr TxBuilder) WithExpiration(expiration int64) TxBuilder {
+	bldr.expiration = expiration
+	return bldr
+}
+
+// WithUnordered returns a copy of the context with an updated unordered flag.
+func (bldr TxBuilder) WithUnordered(unordered bool) TxBuilder {
+	bldr.unordered = unordered
+	return bldr
+}
+
+// WithNonce returns a copy of the context with an updated nonce.
+func (bldr TxBuilder) WithNonce(nonce uint64) TxBuilder {
+	bldr.nonce = nonce
+	return bldr
+}
+
+// WithSignMode returns a copy of the context with an updated sign mode.
+func (bldr TxBuilder) WithSignMode(mode types.SignMode) TxBuilder {
+	bldr.signMode = mode
+	return bldr
+}
+
+// WithCodec returns a copy of the context with an updated codec, which is used to decode the msg data in textual sign mode.
+func (bldr TxBuilder) WithCodec(cdc *codec.Codec) TxBuilder {
+	bldr.cdc = cdc
+	return bldr
+}
+
 // WithAccountNumber returns a copy of the context with an account number.
 func (bldr TxBuilder) WithAccountNumber(accnum uint64) TxBuilder {
 	bldr.accountNumber = accnum
@@ -223,25 +299,59 @@ func (bldr TxBuilder) BuildSignMsg(msgs []sdk.Msg) (StdSignMsg, error) {
 		}
 	}
 
+	sequence, nonce := bldr.sequence, uint64(0)
+	if bldr.unordered {
+		if bldr.expiration == 0 {
+			return StdSignMsg{}, errors.New("unordered tx requires expiration")
+		}
+
+		// unordered txs are signed without sequence
+		sequence, nonce = 0, bldr.nonce
+		if nonce == 0 {
+			var err error
+			if nonce, err = randomNonce(); err != nil {
+				return StdSignMsg{}, err
+			}
+		}
+	}
+
 	return StdSignMsg{
 		ChainID:       bldr.chainID,
 		AccountNumber: bldr.accountNumber,
-		Sequence:      bldr.sequence,
+		Sequence:      sequence,
 		Memo:          bldr.memo,
 		Msg:           msgs,
 		Fee:           NewStdFee(bldr.gas, bldr.FeePayer(), fees),
+		TimeoutHeight: bldr.timeoutHeight,
+		Expiration:    bldr.expiration,
+		Unordered:     bldr.unordered,
+		Nonce:         nonce,
 	}, nil
 }
 
+// randomNonce returns a random non-zero nonce for unordered txs
+func randomNonce() (uint64, error) {
+	var bz [8]byte
+	for {
+		if _, err := rand.Read(bz[:]); err != nil {
+			return 0, err
+		}
+
+		if nonce := binary.BigEndian.Uint64(bz[:]); nonce != 0 {
+			return nonce, nil
+		}
+	}
+}
+
 // Sign signs a transaction given a name, passphrase, and a single message to
 // signed. An error is returned if signing fails.
 func (bldr TxBuilder) Sign(name, passphrase string, msg StdSignMsg) ([]byte, error) {
-	sig, err := MakeSignature(bldr.keybase, name, passphrase, msg)
+	sig, err := bldr.makeSignature(name, passphrase, msg)
 	if err != nil {
 		return nil, err
 	}
 
-	return bldr.txEncoder(NewStdTx(msg.Msg, msg.Fee, []StdSignature{sig}, msg.Memo))
+	return bldr.txEncoder(msg.StdTx([]StdSignature{sig}))
 }
 
 // BuildAndSign builds a single message to be signed, and signs a transaction
@@ -265,7 +375,7 @@ func (bldr TxBuilder) BuildTxForSim(msgs []sdk.Msg) ([]byte, error) {
 
 	// the ante handler will populate with a sentinel pubkey
 	sigs := []StdSignature{{}}
-	return bldr.txEncoder(NewStdTx(signMsg.Msg, signMsg.Fee, sigs, signMsg.Memo))
+	return bldr.txEncoder(signMsg.StdTx(sigs))
 }
 
 // SignStdTx appends a signature to a StdTx and returns a copy of it. If append
@@ -275,13 +385,23 @@ func (bldr TxBuilder) SignStdTx(name, passphrase string, stdTx StdTx, appendSig
 		return StdTx{}, fmt.Errorf("chain ID required but not specified")
 	}
 
-	stdSignature, err := MakeSignature(bldr.keybase, name, passphrase, StdSignMsg{
+	// unordered txs are signed without sequence
+	sequence := bldr.sequence
+	if stdTx.IsUnordered() {
+		sequence = 0
+	}
+
+	stdSignature, err := bldr.makeSignature(name, passphrase, StdSignMsg{
 		ChainID:       bldr.chainID,
 		AccountNumber: bldr.accountNumber,
-		Sequence:      bldr.sequence,
+		Sequence:      sequence,
 		Fee:           stdTx.Fee,
 		Msg:           stdTx.GetMsgs(),
 		Memo:          stdTx.GetMemo(),
+		TimeoutHeight: stdTx.TimeoutHeight,
+		Expiration:    stdTx.Expiration,
+		Unordered:     stdTx.Unordered,
+		Nonce:         stdTx.Nonce,
 	})
 	if err != nil {
 		return
@@ -293,13 +413,34 @@ func (bldr TxBuilder) SignStdTx(name, passphrase string, stdTx StdTx, appendSig
 	} else {
 		sigs = append(sigs, stdSignature)
 	}
-	signedStdTx = NewStdTx(stdTx.GetMsgs(), stdTx.Fee, sigs, stdTx.GetMemo())
+	signedStdTx = NewStdTx(stdTx.GetMsgs(), stdTx.Fee, sigs, stdTx.GetMemo()).
+		WithOptions(stdTx.Options())
 	return
 }
 
+// makeSignature builds a StdSignature in the sign mode of the builder.
+func (bldr TxBuilder) makeSignature(name, passphrase string, msg StdSignMsg) (StdSignature, error) {
+	if bldr.signMode == types.SignModeTextual && bldr.cdc == nil {
+		return StdSignature{}, errors.New("codec required to sign in textual mode")
+	}
+
+	return MakeSignatureWithMode(bldr.keybase, name, passphrase, bldr.cdc, bldr.signMode, msg)
+}
+
 // MakeSignature builds a StdSignature given keybase, key name, passphrase, and a StdSignMsg.
 func MakeSignature(keybase crkeys.Keybase, name, passphrase string,
 	msg StdSignMsg) (sig StdSignature, err error) {
+	return MakeSignatureWithMode(keybase, name, passphrase, nil, types.SignModeDirect, msg)
+}
+
+// MakeSignatureWithMode builds a StdSignature in the sign mode, the codec is used to decode the msg data in textual mode.
+func MakeSignatureWithMode(keybase crkeys.Keybase, name, passphrase string, cdc *codec.Codec,
+	mode types.SignMode, msg StdSignMsg) (sig StdSignature, err error) {
+	signBytes, err := msg.SignBytes(cdc, mode)
+	if err != nil {
+		return
+	}
+
 	if keybase == nil {
 		keybase, err = keys.NewKeyring(sdk.KeyringServiceName(), viper.GetString(flags.FlagKeyringBackend), viper.GetString(flags.FlagHome), os.Stdin)
 		if err != nil {
@@ -307,12 +448,13 @@ func MakeSignature(keybase crkeys.Keybase, name, passphrase string,
 		}
 	}
 
-	sigBytes, pubkey, err := keybase.Sign(name, passphrase, msg.Bytes())
+	sigBytes, pubkey, err := keybase.Sign(name, passphrase, signBytes)
 	if err != nil {
 		return
 	}
 	return StdSignature{
 		PubKey:    pubkey,
 		Signature: sigBytes,
+		SignMode:  mode,
 	}, nil
 }
@@ -87,7 +87,7 @@ func NewAccountIDFromStr(str string) (AccountID, error) {
 		return EmptyAccountID(), nil
 	}
 
-	if len(str) <= NameStrLenMax {
+	if len(str) <= NameV2StrLenMax {
 		n, err := NewName(str)
 		if err != nil {
 			return AccountID{}, err
@@ -197,7 +197,7 @@ func (a *AccountID) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
-	if len(s) <= (NameStrLenMax + 2) {
+	if len(s) <= (NameV2StrLenMax + 2) {
 		// must a name
 		name, err := NewName(s)
 		if err != nil {
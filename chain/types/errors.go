@@ -46,6 +46,7 @@ var (
 	ErrKuMsgFromNotEqual       = sdkerrors.Register(KuCodeSpace, errorCode(kuMsgErrorCodeRoot, 15), "KuMsg from not equal")
 	ErrKuMsgToNotEqual         = sdkerrors.Register(KuCodeSpace, errorCode(kuMsgErrorCodeRoot, 16), "KuMsg to not equal")
 	ErrKuMsgAmountNotEqual     = sdkerrors.Register(KuCodeSpace, errorCode(kuMsgErrorCodeRoot, 17), "KuMsg amount not equal")
+	ErrKuMsgDataNotCanonical   = sdkerrors.Register(KuCodeSpace, errorCode(kuMsgErrorCodeRoot, 18), "KuMsg msg data is not canonical encoded")
 )
 
 var (
@@ -60,4 +61,9 @@ var (
 	ErrNoSignatures    = sdkerrors.Register(KuCodeSpace, errorCode(txErrorCodeRoot, 3), "tx no signers")
 	ErrUnauthorized    = sdkerrors.Register(KuCodeSpace, errorCode(txErrorCodeRoot, 4), "tx wrong number of signers")
 	ErrTxDecode        = sdkerrors.Register(KuCodeSpace, errorCode(txErrorCodeRoot, 5), "tx error decoding")
+	ErrTxTimeoutHeight = sdkerrors.Register(KuCodeSpace, errorCode(txErrorCodeRoot, 6), "tx timeout height exceeded")
+	ErrTxExpired       = sdkerrors.Register(KuCodeSpace, errorCode(txErrorCodeRoot, 7), "tx expired")
+
+	ErrUnorderedTxInvalid   = sdkerrors.Register(KuCodeSpace, errorCode(txErrorCodeRoot, 8), "invalid unordered tx")
+	ErrUnorderedTxDuplicate = sdkerrors.Register(KuCodeSpace, errorCode(txErrorCodeRoot, 9), "unordered tx duplicated")
 )
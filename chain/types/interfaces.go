@@ -41,3 +41,8 @@ type KuMsgData interface {
 type Prettifier interface {
 	PrettifyJSON(cdc *codec.Codec) ([]byte, error)
 }
+
+// DataEncodingValidator a type can check its encoded data is the same as the encoding of the decoded one
+type DataEncodingValidator interface {
+	ValidateDataEncoding(cdc *codec.Codec) error
+}
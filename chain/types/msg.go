@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"encoding/json"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -151,6 +152,30 @@ func (msg KuMsg) PrettifyJSON(cdc *codec.Codec) ([]byte, error) {
 	return cdc.MarshalJSON(alias)
 }
 
+// ValidateDataEncoding checks the data is the same as the encoding of the msg data decoded from it,
+// amino skips unknown fields in decoding, so data with extra fields has the same decoded json.
+func (msg KuMsg) ValidateDataEncoding(cdc *codec.Codec) error {
+	if len(msg.Data) == 0 {
+		return nil
+	}
+
+	var msgData KuMsgData = nil
+	if err := cdc.UnmarshalBinaryLengthPrefixed(msg.Data, &msgData); err != nil {
+		return errors.Wrapf(ErrKuMsgDataUnmarshal, err.Error())
+	}
+
+	bz, err := cdc.MarshalBinaryLengthPrefixed(msgData)
+	if err != nil {
+		return errors.Wrapf(err, "marshal msg data error")
+	}
+
+	if !bytes.Equal(bz, msg.Data) {
+		return ErrKuMsgDataNotCanonical
+	}
+
+	return nil
+}
+
 // ValidateTransferTo validate kumsg is transfer from `from` to `to` with amount
 func (msg KuMsg) ValidateTransferTo(from, to AccountID, amount Coins) error {
 	if amount.IsZero() {
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	yaml "gopkg.in/yaml.v2"
 )
@@ -11,6 +12,10 @@ import (
 const (
 	NameStrLenMax = 17
 
+	// NameV2StrLenMax is the max length of names in version 2, the bytes of them are not longer than
+	// the store key of AccountID, so the names can be used in the keys with fixed length
+	NameV2StrLenMax = 24
+
 	NameBytesLen         = 16
 	NameBytesTypeCodeLen = 1
 	NameBytesVersionLen  = 1
@@ -18,11 +23,19 @@ const (
 	NameBytesHeaderLen   = NameBytesTypeCodeLen + NameBytesVersionLen + NameBytesLengthLen
 
 	NameStrLengthIdx = 2
+	NameVersionIdx   = 1
+
+	NameV2BytesLen = NameBytesHeaderLen + (NameV2StrLenMax*6)/8
 )
 
 const (
-	currentNameType    uint8 = 1
-	currentNameVersion uint8 = 1
+	currentNameType uint8 = 1
+
+	// NameVersionV1 names with at most 17 chars in a-z, 0-9, '@', '.' and '_'
+	NameVersionV1 uint8 = 1
+	// NameVersionV2 names with at most 24 chars which also support '-', used only for
+	// the names which cannot be encoded in version 1
+	NameVersionV2 uint8 = 2
 )
 
 const (
@@ -36,6 +49,7 @@ const (
 	CharValueNil       byte = 63 // 111111
 	CharValueDot       byte = 49 // .
 	CharValueUnderline byte = 50 // _
+	CharValueHyphen    byte = 51 // -, only in version 2
 )
 
 type Name struct {
@@ -50,10 +64,15 @@ func NewName(str string) (Name, error) {
 	return parseName(str)
 }
 
-// NewNameFromBytes create Name from bytes
+// NewNameFromBytes create Name from bytes, the len of bytes is by the version of name
 func NewNameFromBytes(b []byte) Name {
+	bytesLen := NameStrLenMax
+	if len(b) > NameVersionIdx && b[NameVersionIdx] == NameVersionV2 {
+		bytesLen = NameV2BytesLen
+	}
+
 	res := Name{
-		make([]byte, NameStrLenMax),
+		make([]byte, bytesLen),
 	}
 	copy(res.Value[:], b)
 	return res
@@ -74,48 +93,90 @@ func VerifyNameString(str string) bool {
 		return true
 	}
 
+	if !isNameStringV1(str) {
+		return verifyNameStringV2(str)
+	}
+
+	if str[0] == '@' || str[len(str)-1] == '@' {
+		return false
+	}
+
+	return strings.Count(str, "@") <= 1
+}
+
+// isNameStringV1 return if str can be encoded in version 1
+func isNameStringV1(str string) bool {
 	if len(str) > NameStrLenMax {
 		return false
 	}
 
-	if str[0] == '@' || str[len(str)-1] == '@' {
+	for i := 0; i < len(str); i++ {
+		if CharValueNil == char2byte(str[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// verifyNameStringV2 return if str is valid for name in version 2, which should begin and end with
+// a letter or digit, the separators '.', '_' and '-' cannot be adjacent and '@' at most once
+func verifyNameStringV2(str string) bool {
+	if len(str) > NameV2StrLenMax {
 		return false
 	}
 
-	seqCharCount := 0
-	for _, c := range str {
-		if c == '@' {
-			seqCharCount++
+	if !isNameAlphanumeric(str[0]) || !isNameAlphanumeric(str[len(str)-1]) {
+		return false
+	}
+
+	for i := 0; i < len(str); i++ {
+		if CharValueNil == char2byteV2(str[i]) {
+			return false
 		}
-		if CharValueNil == char2byte(byte(c)) {
+
+		if i > 0 && !isNameAlphanumeric(str[i]) && !isNameAlphanumeric(str[i-1]) {
 			return false
 		}
 	}
 
-	return seqCharCount <= 1
+	return strings.Count(str, "@") <= 1
 }
 
-// parseName parse name from string
-func parseName(nameStr string) (Name, error) {
-	nameStrLen := len(nameStr)
-	if nameStrLen > NameStrLenMax {
-		return Name{}, ErrNameParseTooLen
-	}
+func isNameAlphanumeric(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9')
+}
 
+// parseName parse name from string, the names which can be encoded in version 1 are always in version 1,
+// so each string has only one encoding
+func parseName(nameStr string) (Name, error) {
 	if nameStr == "" {
 		return Name{}, nil
 	}
 
-	res := Name{make([]byte, NameStrLenMax)}
+	if isNameStringV1(nameStr) {
+		return packName(nameStr, NameVersionV1, NameStrLenMax, char2byte)
+	}
+
+	if len(nameStr) > NameV2StrLenMax {
+		return Name{}, ErrNameParseTooLen
+	}
+
+	return packName(nameStr, NameVersionV2, NameV2BytesLen, char2byteV2)
+}
+
+// packName packs the chars of name in 6 bits each
+func packName(nameStr string, version uint8, bytesLen int, conv func(c byte) byte) (Name, error) {
+	res := Name{make([]byte, bytesLen)}
 	res.Value[0] = currentNameType
-	res.Value[1] = currentNameVersion
+	res.Value[NameVersionIdx] = version
 
-	res.Value[NameStrLengthIdx] = uint8(nameStrLen)
+	res.Value[NameStrLengthIdx] = uint8(len(nameStr))
 
 	var appendLocTyp uint8 = 0
 	loc := NameBytesHeaderLen
 	for _, c := range nameStr {
-		cc := char2byte(byte(c))
+		cc := conv(byte(c))
 
 		if cc == CharValueNil {
 			return Name{}, ErrNameCharError
@@ -165,6 +226,15 @@ func char2byte(c byte) byte {
 	return CharValueNil
 }
 
+// char2byteV2 char to byte in version 2
+func char2byteV2(c byte) byte {
+	if c == '-' {
+		return CharValueHyphen
+	}
+
+	return char2byte(c)
+}
+
 // byte2char byte convert to char
 func byte2char(c byte) byte {
 	switch c {
@@ -187,8 +257,27 @@ func byte2char(c byte) byte {
 	return '*'
 }
 
+// byte2charV2 byte convert to char in version 2
+func byte2charV2(c byte) byte {
+	if c == CharValueHyphen {
+		return '-'
+	}
+
+	return byte2char(c)
+}
+
 // name2StringV1 name to string in version 1
 func name2StringV1(n Name) []byte {
+	return unpackName(n, byte2char)
+}
+
+// name2StringV2 name to string in version 2
+func name2StringV2(n Name) []byte {
+	return unpackName(n, byte2charV2)
+}
+
+// unpackName unpacks the chars of name in 6 bits each
+func unpackName(n Name, conv func(c byte) byte) []byte {
 	strLen := int(n.Value[NameStrLengthIdx])
 	res := make([]byte, 0, strLen+1)
 
@@ -215,7 +304,7 @@ func name2StringV1(n Name) []byte {
 		appendLocTyp++
 		appendLocTyp &= nameByteMask6
 
-		res = append(res, byte2char(charByte&nameByteMask2))
+		res = append(res, conv(charByte&nameByteMask2))
 	}
 
 	return res
@@ -227,19 +316,29 @@ func (n Name) String() string {
 		return ""
 	}
 
-	switch n.Value[1] {
-	case 1:
+	switch n.Version() {
+	case NameVersionV1:
 		return string(name2StringV1(n))
+	case NameVersionV2:
+		return string(name2StringV2(n))
 	default:
 		return ""
 	}
 }
 
+// Version return the encoding version of name
+func (n Name) Version() uint8 {
+	if len(n.Value) <= NameVersionIdx {
+		return 0
+	}
+	return n.Value[NameVersionIdx]
+}
+
 // Eq if name is eq to other
 func (n Name) Eq(o Name) bool {
-	// Note: When version update, need check
 	if (!n.Empty()) && (!o.Empty()) {
-		return bytes.Equal(n.Value[NameStrLengthIdx:], o.Value[NameStrLengthIdx:])
+		return n.Version() == o.Version() &&
+			bytes.Equal(n.Value[NameStrLengthIdx:], o.Value[NameStrLengthIdx:])
 	}
 
 	return n.Empty() && o.Empty()
@@ -252,32 +351,20 @@ func IsNameEq(r, l Name) bool {
 
 // ForEach for each char to iter
 func (n Name) Foreach(op func(c byte) bool) {
-	strLen := n.Len()
-	var appendLocTyp uint8 = 0
-
-	loc := NameBytesHeaderLen
-	for i := 0; i < strLen; i++ {
-		var charByte byte
-
-		switch appendLocTyp {
-		case 0:
-			charByte |= (n.Value[loc] >> 2)
-		case 1:
-			charByte |= (n.Value[loc] << 4)
-			charByte |= (n.Value[loc+1] >> 4)
-			loc++
-		case 2:
-			charByte |= (n.Value[loc] << 2)
-			charByte |= (n.Value[loc+1] >> 6)
-		case 3:
-			charByte = n.Value[loc+1]
-			loc += 2
-		}
+	if n.Empty() {
+		return
+	}
 
-		appendLocTyp++
-		appendLocTyp &= nameByteMask6
+	var chars []byte
+	switch n.Version() {
+	case NameVersionV1:
+		chars = name2StringV1(n)
+	case NameVersionV2:
+		chars = name2StringV2(n)
+	}
 
-		if !op(byte2char(charByte & nameByteMask2)) {
+	for _, c := range chars {
+		if !op(c) {
 			break
 		}
 	}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 
@@ -41,7 +42,7 @@ func TestName_String(t *testing.T) {
 
 func TestName_ParseErr(t *testing.T) {
 	Convey("test string valid", t, func() {
-		_, err := NewName("kuchain11111111111")
+		_, err := NewName("kuchain111111111111111111")
 		So(err, ShouldEqual, ErrNameStrNoValid)
 
 		_, err1 := NewName("@")
@@ -70,6 +71,85 @@ func TestName_ParseErr(t *testing.T) {
 	})
 }
 
+// testNameV2Convert test the name convert from string in version 2
+func testNameV2Convert(t *testing.T, str string) {
+	Convey(fmt.Sprintf("test name v2 conv %s", str), t, func() {
+		name, err := NewName(str)
+		So(err, ShouldEqual, nil)
+
+		So(name.String(), ShouldEqual, str)
+		So(name.Version(), ShouldEqual, NameVersionV2)
+		So(name.Len(), ShouldEqual, len(str))
+		So(len(name.Value), ShouldEqual, NameV2BytesLen)
+
+		nn := NewNameFromBytes(NewAccountIDFromName(name).StoreKey())
+		So(nn.Eq(name), ShouldBeTrue)
+		So(nn.String(), ShouldEqual, str)
+	})
+}
+
+func TestName_V2(t *testing.T) {
+	testNameV2Convert(t, "kuchain111111111111")
+	testNameV2Convert(t, "ku-chain")
+	testNameV2Convert(t, "a-b")
+	testNameV2Convert(t, "brand.dapp-name@kuchain")
+	testNameV2Convert(t, "kuchain1234567890abcdefg")
+
+	Convey("test names in version 1 not changed", t, func() {
+		So(MustName("kuchainvcdf2322a3").Version(), ShouldEqual, NameVersionV1)
+		So(MustName("kuchain1234567890").Value, ShouldHaveLength, NameStrLenMax)
+		So(NameV2BytesLen, ShouldEqual, AccIDStoreKeyLen)
+	})
+
+	Convey("test name v2 string valid", t, func() {
+		for _, str := range []string{
+			"kuchain1234567890abcdefgh",
+			"-kuchain",
+			"kuchain-",
+			".kuchain1234567890",
+			"kuchain1234567890_",
+			"ku--chain",
+			"ku.-chain",
+			"kuchain1234567890@@ab",
+			"kuchain@12345-abc@d",
+			"ku-chain@",
+			"Ku-chain",
+			"ku-chain!",
+		} {
+			So(VerifyNameString(str), ShouldBeFalse)
+
+			_, err := NewName(str)
+			So(err, ShouldEqual, ErrNameStrNoValid)
+		}
+	})
+
+	Convey("test name eq between versions", t, func() {
+		So(MustName("ku-chain").Eq(MustName("kuchain")), ShouldBeFalse)
+		So(MustName("kuchain1234567890ab").Eq(MustName("kuchain1234567890a")), ShouldBeFalse)
+		So(MustName("ku-chain").Eq(MustName("ku-chain")), ShouldBeTrue)
+	})
+
+	Convey("test name v2 json", t, func() {
+		name := MustName("brand.dapp-name@kuchain")
+		bz, err := json.Marshal(name)
+		So(err, ShouldBeNil)
+		So(string(bz), ShouldEqual, `"brand.dapp-name@kuchain"`)
+
+		var n Name
+		So(json.Unmarshal(bz, &n), ShouldBeNil)
+		So(n.Eq(name), ShouldBeTrue)
+
+		id, err := NewAccountIDFromStr("brand.dapp-name@kuchain")
+		So(err, ShouldBeNil)
+		So(id.MustName().Eq(name), ShouldBeTrue)
+
+		var id2 AccountID
+		So(json.Unmarshal(bz, &id2), ShouldBeNil)
+		So(id2.Eq(id), ShouldBeTrue)
+		So(id2.String(), ShouldEqual, "brand.dapp-name@kuchain")
+	})
+}
+
 func TestName_Char2Byte(t *testing.T) {
 	Convey("char 2 bytes", t, func() {
 		for _, r := range "abcdefghijklmnopqrstuvwxyz" {
@@ -137,6 +217,7 @@ func TestNameEq(t *testing.T) {
 	testNameNoEq(t, "kuchain1212121212", "kuchain1212121213")
 	testNameNoEq(t, "kuchain1212121212", "kuchain121212121@")
 	testNameNoEq(t, "@@@@@@@@@@@@@@@@@", "1@@@@@@@@@@@@@@@@")
+	testNameNoEq(t, "brand-dapp", "brand-dapq")
 }
 
 func TestName_Foreach(t *testing.T) {
@@ -149,6 +230,13 @@ func TestName_Foreach(t *testing.T) {
 			return true
 		})
 		So(string(foreachStr), ShouldEqual, nameStr)
+
+		foreachStr = foreachStr[:0]
+		MustName("brand.dapp-name@kuchain").Foreach(func(c byte) bool {
+			foreachStr = append(foreachStr, c)
+			return true
+		})
+		So(string(foreachStr), ShouldEqual, "brand.dapp-name@kuchain")
 	})
 }
 
@@ -0,0 +1,107 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SignMode the mode of the bytes signed by a signature
+type SignMode uint32
+
+const (
+	// SignModeDirect signs the json of msgs, in which the data of KuMsg are in amino binary
+	SignModeDirect SignMode = iota
+	// SignModeTextual signs the prettified json of msgs, in which the data of KuMsg are decoded,
+	// so external signers such as hardware wallets can display what they sign
+	SignModeTextual
+)
+
+const (
+	signModeDirectName  = "direct"
+	signModeTextualName = "textual"
+)
+
+// ParseSignMode parses the sign mode by name, empty for the direct mode
+func ParseSignMode(str string) (SignMode, error) {
+	switch str {
+	case "", signModeDirectName:
+		return SignModeDirect, nil
+	case signModeTextualName:
+		return SignModeTextual, nil
+	default:
+		return SignModeDirect, fmt.Errorf("invalid sign mode %q, should be %s or %s", str, signModeDirectName, signModeTextualName)
+	}
+}
+
+func (m SignMode) String() string {
+	switch m {
+	case SignModeDirect:
+		return signModeDirectName
+	case SignModeTextual:
+		return signModeTextualName
+	default:
+		return fmt.Sprintf("unknown(%d)", uint32(m))
+	}
+}
+
+// StdSignTextualBytes returns the bytes to sign in textual mode, which are the same doc as StdSignBytes,
+// but the msgs are in the json by PrettifyJSON, and the sign mode is in the doc to distinguish the modes.
+// The data of msgs must be canonical encoded, so that the signed json covers all the bytes of data.
+func StdSignTextualBytes(cdc *codec.Codec, chainID string, accnum uint64, sequence uint64, fee StdFee, msgs []sdk.Msg, memo string, opts TxOptions) ([]byte, error) {
+	msgsBytes := make([]json.RawMessage, 0, len(msgs))
+	for _, msg := range msgs {
+		if validator, ok := msg.(DataEncodingValidator); ok {
+			if err := validator.ValidateDataEncoding(cdc); err != nil {
+				return nil, errors.Wrapf(err, "msg %s", msg.Type())
+			}
+		}
+
+		prettifier, ok := msg.(Prettifier)
+		if !ok {
+			msgsBytes = append(msgsBytes, json.RawMessage(msg.GetSignBytes()))
+			continue
+		}
+
+		raw, err := prettifier.PrettifyJSON(cdc)
+		if err != nil {
+			return nil, errors.Wrapf(err, "prettify json of msg %s", msg.Type())
+		}
+
+		msgsBytes = append(msgsBytes, json.RawMessage(raw))
+	}
+
+	bz, err := ModuleCdc.MarshalJSON(StdSignDoc{
+		AccountNumber: accnum,
+		ChainID:       chainID,
+		Fee:           json.RawMessage(fee.Bytes()),
+		Memo:          memo,
+		Msg:           msgsBytes,
+		Sequence:      sequence,
+		TimeoutHeight: opts.TimeoutHeight,
+		Expiration:    opts.Expiration,
+		Unordered:     opts.Unordered,
+		Nonce:         opts.Nonce,
+		SignMode:      signModeTextualName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sdk.SortJSON(bz)
+}
+
+// StdSignBytesByMode returns the bytes to sign in the sign mode, the codec is used to decode the data of msgs in textual mode
+func StdSignBytesByMode(cdc *codec.Codec, mode SignMode, chainID string, accnum uint64, sequence uint64, fee StdFee, msgs []sdk.Msg, memo string, opts TxOptions) ([]byte, error) {
+	switch mode {
+	case SignModeDirect:
+		return StdSignBytes(chainID, accnum, sequence, fee, msgs, memo, opts), nil
+	case SignModeTextual:
+		return StdSignTextualBytes(cdc, chainID, accnum, sequence, fee, msgs, memo, opts)
+	default:
+		return nil, errors.Wrapf(errors.ErrUnauthorized, "unknown sign mode %d", uint32(mode))
+	}
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -14,9 +15,34 @@ type StdSignMsg struct {
 	Fee           StdFee    `json:"fee" yaml:"fee"`
 	Msg           []sdk.Msg `json:"msg" yaml:"msg"`
 	Memo          string    `json:"memo" yaml:"memo"`
+
+	TimeoutHeight uint64 `json:"timeout_height,omitempty" yaml:"timeout_height"`
+	Expiration    int64  `json:"expiration,omitempty" yaml:"expiration"`
+	Unordered     bool   `json:"unordered,omitempty" yaml:"unordered"`
+	Nonce         uint64 `json:"nonce,omitempty" yaml:"nonce"`
 }
 
 // get message bytes
 func (msg StdSignMsg) Bytes() []byte {
-	return StdSignBytes(msg.ChainID, msg.AccountNumber, msg.Sequence, msg.Fee, msg.Msg, msg.Memo)
+	return StdSignBytes(msg.ChainID, msg.AccountNumber, msg.Sequence, msg.Fee, msg.Msg, msg.Memo, msg.Options())
+}
+
+// SignBytes returns the bytes to sign in the sign mode, the codec is used to decode the data of msgs in textual mode
+func (msg StdSignMsg) SignBytes(cdc *codec.Codec, mode SignMode) ([]byte, error) {
+	return StdSignBytesByMode(cdc, mode, msg.ChainID, msg.AccountNumber, msg.Sequence, msg.Fee, msg.Msg, msg.Memo, msg.Options())
+}
+
+// Options returns the optional fields of the tx to sign
+func (msg StdSignMsg) Options() TxOptions {
+	return TxOptions{
+		TimeoutHeight: msg.TimeoutHeight,
+		Expiration:    msg.Expiration,
+		Unordered:     msg.Unordered,
+		Nonce:         msg.Nonce,
+	}
+}
+
+// StdTx returns the tx of the msg with the signatures
+func (msg StdSignMsg) StdTx(sigs []StdSignature) StdTx {
+	return NewStdTx(msg.Msg, msg.Fee, sigs, msg.Memo).WithOptions(msg.Options())
 }
@@ -11,6 +11,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/multisig"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 	yaml "gopkg.in/yaml.v2"
 )
 
@@ -27,6 +28,24 @@ type StdTx struct {
 	Fee        StdFee         `json:"fee" yaml:"fee"`
 	Signatures []StdSignature `json:"signatures" yaml:"signatures"`
 	Memo       string         `json:"memo" yaml:"memo"`
+
+	// TimeoutHeight is the last block height the tx can be included in, 0 for no timeout
+	TimeoutHeight uint64 `json:"timeout_height,omitempty" yaml:"timeout_height"`
+	// Expiration is the unix time in seconds after which the tx is rejected, 0 for never expire
+	Expiration int64 `json:"expiration,omitempty" yaml:"expiration"`
+	// Unordered txs are signed without sequence and not ordered with other txs of signers,
+	// which require the expiration and a nonce, replays are rejected by the hash of tx until expired
+	Unordered bool `json:"unordered,omitempty" yaml:"unordered"`
+	// Nonce makes the hash different for unordered txs with the same content
+	Nonce uint64 `json:"nonce,omitempty" yaml:"nonce"`
+}
+
+// TxOptions the optional fields of tx, which are in the sign bytes only if set
+type TxOptions struct {
+	TimeoutHeight uint64
+	Expiration    int64
+	Unordered     bool
+	Nonce         uint64
 }
 
 func NewStdTx(msgs []sdk.Msg, fee StdFee, sigs []StdSignature, memo string) StdTx {
@@ -38,6 +57,41 @@ func NewStdTx(msgs []sdk.Msg, fee StdFee, sigs []StdSignature, memo string) StdT
 	}
 }
 
+// WithTimeout returns a copy of the tx with the timeout height and expiration
+func (tx StdTx) WithTimeout(timeoutHeight uint64, expiration int64) StdTx {
+	tx.TimeoutHeight = timeoutHeight
+	tx.Expiration = expiration
+	return tx
+}
+
+// Options returns the optional fields of the tx
+func (tx StdTx) Options() TxOptions {
+	return TxOptions{
+		TimeoutHeight: tx.TimeoutHeight,
+		Expiration:    tx.Expiration,
+		Unordered:     tx.Unordered,
+		Nonce:         tx.Nonce,
+	}
+}
+
+// WithOptions returns a copy of the tx with the optional fields
+func (tx StdTx) WithOptions(opts TxOptions) StdTx {
+	tx.TimeoutHeight = opts.TimeoutHeight
+	tx.Expiration = opts.Expiration
+	tx.Unordered = opts.Unordered
+	tx.Nonce = opts.Nonce
+	return tx
+}
+
+// IsUnordered returns if the tx is unordered
+func (tx StdTx) IsUnordered() bool { return tx.Unordered }
+
+// GetTimeoutHeight returns the last block height the tx can be included in, 0 for no timeout
+func (tx StdTx) GetTimeoutHeight() uint64 { return tx.TimeoutHeight }
+
+// GetExpiration returns the unix time in seconds after which the tx is rejected, 0 for never expire
+func (tx StdTx) GetExpiration() int64 { return tx.Expiration }
+
 // GetMsgs returns the all the transaction's messages.
 func (tx StdTx) GetMsgs() []sdk.Msg { return tx.Msgs }
 
@@ -66,6 +120,14 @@ func (tx StdTx) ValidateBasic() error {
 		return sdkerrors.ErrMemoTooLarge
 	}
 
+	if tx.Expiration < 0 {
+		return errors.Wrapf(ErrTxExpired, "invalid expiration %d", tx.Expiration)
+	}
+
+	if tx.Unordered && (tx.Expiration == 0 || tx.Nonce == 0) {
+		return errors.Wrap(ErrUnorderedTxInvalid, "unordered tx requires expiration and nonce")
+	}
+
 	return nil
 }
 
@@ -122,11 +184,20 @@ func (tx StdTx) PrettifyJSON(cdc *codec.Codec) ([]byte, error) {
 		Fee        StdFee            `json:"fee" yaml:"fee"`
 		Signatures []StdSignature    `json:"signatures" yaml:"signatures"`
 		Memo       string            `json:"memo" yaml:"memo"`
+
+		TimeoutHeight uint64 `json:"timeout_height,omitempty" yaml:"timeout_height"`
+		Expiration    int64  `json:"expiration,omitempty" yaml:"expiration"`
+		Unordered     bool   `json:"unordered,omitempty" yaml:"unordered"`
+		Nonce         uint64 `json:"nonce,omitempty" yaml:"nonce"`
 	}{
-		Fee:        tx.Fee,
-		Signatures: tx.Signatures,
-		Memo:       tx.Memo,
-		Msgs:       make([]json.RawMessage, 0, len(tx.Msgs)),
+		Fee:           tx.Fee,
+		Signatures:    tx.Signatures,
+		Memo:          tx.Memo,
+		Msgs:          make([]json.RawMessage, 0, len(tx.Msgs)),
+		TimeoutHeight: tx.TimeoutHeight,
+		Expiration:    tx.Expiration,
+		Unordered:     tx.Unordered,
+		Nonce:         tx.Nonce,
 	}
 
 	for _, msg := range tx.Msgs {
@@ -214,6 +285,8 @@ func (fee StdFee) GasPrices() DecCoins {
 // as well as the ChainID (prevent cross chain replay)
 // and the Sequence numbers for each signature (prevent
 // inchain replay and enforce tx ordering per account).
+// The optional fields are omitted if not set, so the sign
+// bytes of txs without them are not changed.
 type StdSignDoc struct {
 	AccountNumber uint64            `json:"account_number" yaml:"account_number"`
 	ChainID       string            `json:"chain_id" yaml:"chain_id"`
@@ -221,10 +294,15 @@ type StdSignDoc struct {
 	Memo          string            `json:"memo" yaml:"memo"`
 	Msg           []json.RawMessage `json:"msg" yaml:"msg"`
 	Sequence      uint64            `json:"sequence" yaml:"sequence"`
+	TimeoutHeight uint64            `json:"timeout_height,omitempty" yaml:"timeout_height"`
+	Expiration    int64             `json:"expiration,omitempty" yaml:"expiration"`
+	Unordered     bool              `json:"unordered,omitempty" yaml:"unordered"`
+	Nonce         uint64            `json:"nonce,omitempty" yaml:"nonce"`
+	SignMode      string            `json:"sign_mode,omitempty" yaml:"sign_mode"`
 }
 
 // StdSignBytes returns the bytes to sign for a transaction.
-func StdSignBytes(chainID string, accnum uint64, sequence uint64, fee StdFee, msgs []sdk.Msg, memo string) []byte {
+func StdSignBytes(chainID string, accnum uint64, sequence uint64, fee StdFee, msgs []sdk.Msg, memo string, opts TxOptions) []byte {
 	var msgsBytes []json.RawMessage
 	for _, msg := range msgs {
 		msgsBytes = append(msgsBytes, json.RawMessage(msg.GetSignBytes()))
@@ -236,6 +314,10 @@ func StdSignBytes(chainID string, accnum uint64, sequence uint64, fee StdFee, ms
 		Memo:          memo,
 		Msg:           msgsBytes,
 		Sequence:      sequence,
+		TimeoutHeight: opts.TimeoutHeight,
+		Expiration:    opts.Expiration,
+		Unordered:     opts.Unordered,
+		Nonce:         opts.Nonce,
 	})
 	if err != nil {
 		panic(err)
@@ -243,10 +325,28 @@ func StdSignBytes(chainID string, accnum uint64, sequence uint64, fee StdFee, ms
 	return sdk.MustSortJSON(bz)
 }
 
+// UnorderedTxHash returns the hash of an unordered tx to reject replays, which is of the document signed
+// by the fee payer in its sign mode, without account number and sequence, so it is not changed by the
+// signatures or by the bytes of tx not covered by the signature.
+func UnorderedTxHash(cdc *codec.Codec, chainID string, tx StdTx) ([]byte, error) {
+	mode := SignModeDirect
+	if len(tx.Signatures) > 0 {
+		mode = tx.Signatures[0].SignMode
+	}
+
+	bz, err := StdSignBytesByMode(cdc, mode, chainID, 0, 0, tx.Fee, tx.Msgs, tx.Memo, tx.Options())
+	if err != nil {
+		return nil, err
+	}
+
+	return tmhash.Sum(bz), nil
+}
+
 // StdSignature represents a sig
 type StdSignature struct {
 	crypto.PubKey `json:"pub_key" yaml:"pub_key"` // optional
 	Signature     []byte                          `json:"signature" yaml:"signature"`
+	SignMode      SignMode                        `json:"sign_mode,omitempty" yaml:"sign_mode"`
 }
 
 // DefaultTxDecoder logic for standard transaction decoding
@@ -294,9 +394,11 @@ func (ss StdSignature) MarshalYAML() (interface{}, error) {
 	bz, err = yaml.Marshal(struct {
 		PubKey    string
 		Signature string
+		SignMode  string
 	}{
 		PubKey:    pubkey,
 		Signature: fmt.Sprintf("%s", ss.Signature),
+		SignMode:  ss.SignMode.String(),
 	})
 	if err != nil {
 		return nil, err
@@ -67,6 +67,7 @@ func main() {
 
 	rootCmd.AddCommand(flags.NewCompletionCmd(rootCmd, true))
 	rootCmd.AddCommand(replayCmd())
+	rootCmd.AddCommand(pluginsCmd(ctx))
 	rootCmd.AddCommand(debug.Cmd(cdc))
 
 	rootCmd.AddCommand(versionCmd(ctx))
@@ -0,0 +1,250 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	kuapp "github.com/KuChainNetwork/kuchain/app"
+	"github.com/KuChainNetwork/kuchain/chain/client/txutil"
+	"github.com/KuChainNetwork/kuchain/plugins"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmcfg "github.com/tendermint/tendermint/config"
+	tmos "github.com/tendermint/tendermint/libs/os"
+	"github.com/tendermint/tendermint/node"
+	tmsm "github.com/tendermint/tendermint/state"
+	tmstore "github.com/tendermint/tendermint/store"
+	dbm "github.com/tendermint/tm-db"
+
+	// plugins built into kucd, each one register itself in its `init()`
+	_ "github.com/KuChainNetwork/kuchain/plugins/db_history"
+	_ "github.com/KuChainNetwork/kuchain/plugins/stream"
+	_ "github.com/KuChainNetwork/kuchain/plugins/test"
+)
+
+const (
+	flagBackfillFrom  = "from"
+	flagBackfillTo    = "to"
+	flagBackfillBatch = "batch"
+	flagBackfillReset = "reset"
+)
+
+// pluginsCmd commands for plugins
+func pluginsCmd(ctx *server.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "plugins",
+		Short: "Plugins subcommands",
+	}
+
+	cmd.AddCommand(
+		pluginsListCmd(),
+		pluginsBackfillCmd(ctx),
+	)
+
+	return cmd
+}
+
+func pluginsListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List available plugins and the plugins enabled by the plugin config",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfgs, err := loadPluginCfgs(viper.GetString(FlagPluginCfgPath))
+			if err != nil {
+				return err
+			}
+
+			enabled := make(map[string]bool, len(cfgs))
+			for _, cfg := range cfgs {
+				enabled[cfg.Name] = true
+			}
+
+			fmt.Println("available plugins:")
+			for _, name := range plugins.RegisteredPlugins() {
+				status := "disabled"
+				if enabled[name] {
+					status = "enabled"
+				}
+				fmt.Printf("  %-20s %s\n", name, status)
+			}
+
+			if err := plugins.ValidateCfgs(cfgs); err != nil {
+				return errors.Wrap(err, "invalid plugin config")
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagPluginCfgPath, "", "Config file path for plugins")
+
+	return cmd
+}
+
+func pluginsBackfillCmd(ctx *server.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "backfill",
+		Short: "Feed the blocks between two heights in the data directory to plugins",
+		Long: `Feed the blocks between two heights in the data directory to plugins, the blocks and
+their results are read from the block store and the state db, so the blocks are not executed again.
+The node should be stopped, or use a copy of its home directory, as the dbs cannot be opened by
+two processes. The backfill is done in batches, an interrupted backfill is resumed from the last batch
+with the same command.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return backfillPlugins(ctx,
+				viper.GetInt64(flagBackfillFrom),
+				viper.GetInt64(flagBackfillTo),
+				viper.GetInt64(flagBackfillBatch),
+				viper.GetBool(flagBackfillReset))
+		},
+	}
+
+	cmd.Flags().String(FlagPluginCfgPath, "", "Config file path for plugins")
+	cmd.Flags().Int64(flagBackfillFrom, 1, "Height of the first block to backfill")
+	cmd.Flags().Int64(flagBackfillTo, 0, "Height of the last block to backfill, 0 for the last block committed")
+	cmd.Flags().Int64(flagBackfillBatch, 100, "Number of blocks flushed by plugins in a batch")
+	cmd.Flags().Bool(flagBackfillReset, false, "Ignore the progress of the last backfill")
+
+	return cmd
+}
+
+func backfillPlugins(ctx *server.Context, from, to, batch int64, reset bool) error {
+	cfgs, err := loadPluginCfgs(viper.GetString(FlagPluginCfgPath))
+	if err != nil {
+		return err
+	}
+
+	if len(cfgs) == 0 {
+		return errors.New("no plugins to backfill, set the plugins by --plugin-cfg")
+	}
+
+	// all blocks should be handled by plugins in backfill
+	for i := range cfgs {
+		cfgs[i].Queue.Overflow = plugins.OverflowBlock
+	}
+
+	cfg := ctx.Config
+
+	blockStoreDB, err := openReadOnlyDB("blockstore", cfg.DBDir())
+	if err != nil {
+		return err
+	}
+	defer blockStoreDB.Close()
+
+	stateDB, err := openReadOnlyDB("state", cfg.DBDir())
+	if err != nil {
+		return err
+	}
+	defer stateDB.Close()
+
+	blockStore := tmstore.NewBlockStore(blockStoreDB)
+	lastHeight := tmsm.LoadState(stateDB).LastBlockHeight
+	if to <= 0 || to > lastHeight {
+		to = lastHeight
+	}
+
+	if from < blockStore.Base() {
+		return fmt.Errorf("blocks before %d are pruned", blockStore.Base())
+	}
+
+	if from > to {
+		return fmt.Errorf("no blocks to backfill in [%d, %d]", from, to)
+	}
+
+	progressDB, err := dbm.NewGoLevelDB("plugins_backfill", cfg.DBDir())
+	if err != nil {
+		return err
+	}
+	defer progressDB.Close()
+
+	if reset {
+		names := make([]string, 0, len(cfgs))
+		for _, c := range cfgs {
+			names = append(names, c.Name)
+		}
+
+		if err := plugins.ResetBackfillProgress(progressDB, names, from, to); err != nil {
+			return err
+		}
+	}
+
+	cdc := kuapp.MakeCodec()
+	pluginCtx := plugins.NewContext(ctx.Logger).WithCodec(cdc)
+	if err := plugins.InitPlugins(pluginCtx, cfgs); err != nil {
+		return err
+	}
+	defer plugins.StopPlugins(pluginCtx)
+
+	ctx.Logger.Info("backfill blocks to plugins", "from", from, "to", to, "batch", batch)
+
+	return plugins.BackfillBlocks(plugins.BlockSource{
+		BlockStore: blockStore,
+		StateDB:    stateDB,
+		TxDecoder:  txutil.DefaultTxDecoder(cdc),
+	}, progressDB, from, to, batch)
+}
+
+// openReadOnlyDB opens a goleveldb in read-only mode
+func openReadOnlyDB(name, dir string) (dbm.DB, error) {
+	return dbm.NewGoLevelDBWithOpts(name, dir, &opt.Options{
+		ReadOnly:       true,
+		ErrorIfMissing: true,
+	})
+}
+
+// replayPlugins replays the blocks committed by app but missed by plugins
+func replayPlugins(cfg *tmcfg.Config, app abci.Application) error {
+	if !plugins.Enabled() {
+		return nil
+	}
+
+	blockStoreDB, err := node.DefaultDBProvider(&node.DBContext{ID: "blockstore", Config: cfg})
+	if err != nil {
+		return err
+	}
+	defer blockStoreDB.Close()
+
+	stateDB, err := node.DefaultDBProvider(&node.DBContext{ID: "state", Config: cfg})
+	if err != nil {
+		return err
+	}
+	defer stateDB.Close()
+
+	// blocks not committed by app will be replayed to app by tendermint, so plugins will get them
+	height := app.Info(abci.RequestInfo{}).LastBlockHeight
+	if state := tmsm.LoadState(stateDB); state.LastBlockHeight < height {
+		height = state.LastBlockHeight
+	}
+
+	return plugins.ReplayBlocks(plugins.BlockSource{
+		BlockStore: tmstore.NewBlockStore(blockStoreDB),
+		StateDB:    stateDB,
+		TxDecoder:  txutil.DefaultTxDecoder(kuapp.MakeCodec()),
+	}, height)
+}
+
+// loadPluginCfgs load plugin configs from file, return nil if no file
+func loadPluginCfgs(cfgFilePath string) ([]plugins.BaseCfg, error) {
+	if cfgFilePath == "" {
+		return nil, nil
+	}
+
+	pluginCfg := struct {
+		Plugins []plugins.BaseCfg
+	}{}
+
+	raws, err := tmos.ReadFile(cfgFilePath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "read plugin file %s err", cfgFilePath)
+	}
+
+	if err := json.Unmarshal(raws, &pluginCfg); err != nil {
+		return nil, errors.Wrapf(err, "unmarshal plugin config")
+	}
+
+	return pluginCfg.Plugins, nil
+}
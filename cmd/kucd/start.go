@@ -1,17 +1,16 @@
 package main
 
 import (
-	"encoding/json"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
 	"runtime/pprof"
 
+	"github.com/KuChainNetwork/kuchain/app"
 	"github.com/KuChainNetwork/kuchain/plugins"
 	"github.com/cosmos/cosmos-sdk/server"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	abciServer "github.com/tendermint/tendermint/abci/server"
@@ -179,27 +178,28 @@ func startStandAlone(ctx *server.Context, appCreator server.AppCreator) error {
 }
 
 func initPlugins(ctx *server.Context) error {
-	cfgFilePath := viper.GetString(FlagPluginCfgPath)
-	if cfgFilePath == "" {
+	cfgs, err := loadPluginCfgs(viper.GetString(FlagPluginCfgPath))
+	if err != nil {
+		return err
+	}
+
+	if len(cfgs) == 0 {
 		ctx.Logger.Debug("no need start plugins")
 		return nil
 	}
 
-	pluginCfg := struct {
-		Plugins []plugins.BaseCfg
-	}{}
-
-	raws, err := tmos.ReadFile(cfgFilePath)
-	if err != nil {
-		return errors.Wrapf(err, "read plugin file %s err", cfgFilePath)
+	metrics := plugins.NopMetrics()
+	if instrumentation := ctx.Config.Instrumentation; instrumentation.Prometheus {
+		metrics = plugins.PrometheusMetrics(instrumentation.Namespace)
 	}
 
-	if err := json.Unmarshal(raws, &pluginCfg); err != nil {
-		return errors.Wrapf(err, "unmarshal plugin config")
-	}
+	cfg := ctx.Config
+	ackDB := dbm.NewDB("plugins", dbm.BackendType(cfg.DBBackend), cfg.DBDir())
 
-	pluginCtx := plugins.NewContext(ctx.Logger)
-	return plugins.InitPlugins(pluginCtx, pluginCfg.Plugins)
+	pluginCtx := plugins.NewContext(ctx.Logger).WithCodec(app.MakeCodec())
+	return plugins.InitPlugins(pluginCtx, cfgs,
+		plugins.WithAckDB(ackDB),
+		plugins.WithMetrics(metrics))
 }
 
 func startInProcess(ctx *server.Context, appCreator server.AppCreator) (*node.Node, error) {
@@ -223,6 +223,10 @@ func startInProcess(ctx *server.Context, appCreator server.AppCreator) (*node.No
 
 	app := appCreator(ctx.Logger, db, traceWriter)
 
+	if err := replayPlugins(cfg, app); err != nil {
+		return nil, err
+	}
+
 	nodeKey, err := p2p.LoadOrGenNodeKey(cfg.NodeKeyFile())
 	if err != nil {
 		return nil, err
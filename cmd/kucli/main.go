@@ -64,7 +64,7 @@ func main() {
 		flags.LineBreak,
 		lcd.ServeCommand(cdc, registerRoutes),
 		flags.LineBreak,
-		keys.Commands(),
+		keysCmd(),
 		flags.LineBreak,
 		version.Cmd,
 		flags.NewCompletionCmd(rootCmd, true),
@@ -103,6 +103,13 @@ func queryCmd(cdc *amino.Codec) *cobra.Command {
 	return queryCmd
 }
 
+func keysCmd() *cobra.Command {
+	cmd := keys.Commands()
+	cmd.AddCommand(kuKeys.AccountsCommand())
+
+	return cmd
+}
+
 func txCmd(cdc *amino.Codec) *cobra.Command {
 	txCmd := &cobra.Command{
 		Use:   "tx",
@@ -113,6 +120,7 @@ func txCmd(cdc *amino.Codec) *cobra.Command {
 		flags.LineBreak,
 		txCli.GetSignCommand(cdc),
 		txCli.GetMultiSignCommand(cdc),
+		txCli.GetPartialCommand(cdc),
 		flags.LineBreak,
 		txcmd.GetBroadcastCommand(cdc),
 		txcmd.GetEncodeCommand(cdc),
@@ -5,6 +5,7 @@ go 1.14
 require (
 	github.com/99designs/keyring v1.1.4 // indirect
 	github.com/cosmos/cosmos-sdk v0.38.5
+	github.com/go-kit/kit v0.10.0
 	github.com/go-pg/pg/v10 v10.0.0-beta.1
 	github.com/gogo/protobuf v1.3.1
 	github.com/golang/mock v1.4.1 // indirect
@@ -12,6 +13,7 @@ require (
 	github.com/gorilla/mux v1.7.4
 	github.com/otiai10/copy v1.1.1
 	github.com/pkg/errors v0.9.1
+	github.com/prometheus/client_golang v1.5.1
 	github.com/rakyll/statik v0.1.7 // indirect
 	github.com/smartystreets/assertions v1.0.1 // indirect
 	github.com/smartystreets/goconvey v1.6.4
@@ -19,6 +21,7 @@ require (
 	github.com/spf13/pflag v1.0.5
 	github.com/spf13/viper v1.6.3
 	github.com/stretchr/testify v1.5.1
+	github.com/syndtr/goleveldb v1.0.1-0.20190923125748-758128399b1d
 	github.com/tendermint/go-amino v0.15.1
 	github.com/tendermint/tendermint v0.33.6
 	github.com/tendermint/tm-db v0.5.1
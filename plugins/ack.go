@@ -0,0 +1,43 @@
+package plugins
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+var ackKeyPrefix = []byte("ack/")
+
+func ackKey(name string) []byte {
+	return append(append([]byte{}, ackKeyPrefix...), []byte(name)...)
+}
+
+// ackStore persists the height of the last block acknowledged by each plugin
+type ackStore struct {
+	db dbm.DB
+}
+
+func newAckStore(db dbm.DB) *ackStore {
+	return &ackStore{
+		db: db,
+	}
+}
+
+// get returns the height of the last block acknowledged by plugin, 0 if none
+func (s *ackStore) get(name string) (int64, error) {
+	bz, err := s.db.Get(ackKey(name))
+	if err != nil || len(bz) == 0 {
+		return 0, err
+	}
+
+	return int64(binary.BigEndian.Uint64(bz)), nil
+}
+
+func (s *ackStore) set(name string, height int64) error {
+	return s.db.SetSync(ackKey(name), sdk.Uint64ToBigEndian(uint64(height)))
+}
+
+func (s *ackStore) close() error {
+	return s.db.Close()
+}
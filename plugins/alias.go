@@ -6,15 +6,29 @@ import (
 )
 
 type (
-	BaseCfg = types.BaseCfg
-	Plugin  = types.Plugin
-	Context = types.Context
+	BaseCfg  = types.BaseCfg
+	QueueCfg = types.QueueCfg
+	Plugin   = types.Plugin
+	Context  = types.Context
+	TxResult = types.TxResult
+
+	PluginTxResultHandler   = types.PluginTxResultHandler
+	PluginBeginBlockHandler = types.PluginBeginBlockHandler
+	PluginEndBlockHandler   = types.PluginEndBlockHandler
+	PluginCommitHandler     = types.PluginCommitHandler
 )
 
 var (
 	NewContext = types.NewContext
+	NewCtx     = types.NewCtx
 )
 
 type (
 	StdTx = chainTypes.StdTx
 )
+
+const (
+	OverflowBlock = types.OverflowBlock
+	OverflowDrop  = types.OverflowDrop
+	OverflowSpill = types.OverflowSpill
+)
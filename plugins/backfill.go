@@ -0,0 +1,133 @@
+package plugins
+
+import (
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/plugins/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// backfillProgressKey the key of the height of the last block in [from, to] backfilled to plugin in the progress db,
+// so a backfill is only resumed by the one of the same plugin and range
+func backfillProgressKey(name string, from, to int64) string {
+	return fmt.Sprintf("backfill/%s/%d-%d", name, from, to)
+}
+
+// BackfillBlocks feeds the blocks in [from, to] to plugins in batches, after each batch is handled
+// and flushed by all plugins, the height is saved to progressDB for each plugin, so a backfill
+// interrupted will be resumed from the next batch by the backfill of the same range.
+func BackfillBlocks(src BlockSource, progressDB dbm.DB, from, to, batch int64) error {
+	if plugins == nil {
+		return fmt.Errorf("no plugins to backfill")
+	}
+
+	return plugins.backfillBlocks(src, newAckStore(progressDB), from, to, batch)
+}
+
+func (p *Plugins) backfillBlocks(src BlockSource, progress *ackStore, from, to, batch int64) error {
+	if batch <= 0 {
+		return fmt.Errorf("invalid batch size %d", batch)
+	}
+
+	// the height of the last block backfilled to each plugin, blocks are only fed to plugins not backfilled them
+	done := make([]int64, len(p.runners))
+	start := to + 1
+	for i, r := range p.runners {
+		height, err := progress.get(backfillProgressKey(r.plugin.Name(), from, to))
+		if err != nil {
+			return err
+		}
+
+		if height < from {
+			height = from - 1
+		}
+
+		done[i] = height
+		if height+1 < start {
+			start = height + 1
+		}
+	}
+
+	if start > to {
+		p.logger.Info("blocks already backfilled", "from", from, "to", to)
+		return nil
+	}
+
+	if start > from {
+		p.logger.Info("resume backfill", "from", start)
+	}
+
+	// blocks are fed to all plugins whatever they acked, reset the acked height to wait them handled
+	for i, r := range p.runners {
+		r.resetAcked(done[i])
+	}
+
+	for batchStart := start; batchStart <= to; batchStart += batch {
+		end := batchStart + batch - 1
+		if end > to {
+			end = to
+		}
+
+		for h := batchStart; h <= end; h++ {
+			runners := make([]*pluginRunner, 0, len(p.runners))
+			for i, r := range p.runners {
+				if done[i] < h {
+					runners = append(runners, r)
+				}
+			}
+
+			if err := p.replayBlock(src, h, runners); err != nil {
+				return fmt.Errorf("backfill block %d to plugins: %s", h, err.Error())
+			}
+		}
+
+		if err := p.flush(end); err != nil {
+			return fmt.Errorf("flush plugins at %d: %s", end, err.Error())
+		}
+
+		for i, r := range p.runners {
+			if done[i] >= end {
+				continue
+			}
+
+			if err := progress.set(backfillProgressKey(r.plugin.Name(), from, to), end); err != nil {
+				return err
+			}
+			done[i] = end
+		}
+
+		p.logger.Info("blocks backfilled", "from", batchStart, "to", end)
+	}
+
+	return nil
+}
+
+// flush waits until all plugins handled the block at height and persisted their data
+func (p *Plugins) flush(height int64) error {
+	ctx := types.NewContext(p.logger)
+
+	for _, r := range p.runners {
+		if err := r.waitHandled(height); err != nil {
+			return err
+		}
+
+		if flusher, ok := r.plugin.(types.PluginFlusher); ok {
+			if err := flusher.Flush(ctx); err != nil {
+				return fmt.Errorf("plugin %s: %s", r.plugin.Name(), err.Error())
+			}
+		}
+	}
+
+	return nil
+}
+
+// ResetBackfillProgress removes the progress of the backfill of plugins in [from, to]
+func ResetBackfillProgress(progressDB dbm.DB, names []string, from, to int64) error {
+	for _, name := range names {
+		if err := progressDB.DeleteSync(ackKey(backfillProgressKey(name, from, to))); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package plugins
+
+import (
+	"testing"
+	"time"
+
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmsm "github.com/tendermint/tendermint/state"
+	tmstore "github.com/tendermint/tendermint/store"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// makeBlockSource makes a block source with blocks in [1, height], each block has a tx
+func makeBlockSource(height int64) BlockSource {
+	blockStore := tmstore.NewBlockStore(dbm.NewMemDB())
+	stateDB := dbm.NewMemDB()
+
+	for h := int64(1); h <= height; h++ {
+		block := tmtypes.MakeBlock(h, []tmtypes.Tx{tmtypes.Tx("tx")}, &tmtypes.Commit{}, nil)
+		block.ChainID = "testchain"
+		block.Time = time.Now().UTC()
+
+		parts := block.MakePartSet(tmtypes.BlockPartSizeBytes)
+		blockStore.SaveBlock(block, parts, &tmtypes.Commit{Height: h})
+
+		tmsm.SaveABCIResponses(stateDB, h, &tmsm.ABCIResponses{
+			DeliverTxs: []*abci.ResponseDeliverTx{{GasUsed: h}},
+			EndBlock:   &abci.ResponseEndBlock{},
+			BeginBlock: &abci.ResponseBeginBlock{},
+		})
+	}
+
+	return BlockSource{
+		BlockStore: blockStore,
+		StateDB:    stateDB,
+		TxDecoder: func(txBytes []byte) (sdk.Tx, error) {
+			return chainTypes.StdTx{Memo: string(txBytes)}, nil
+		},
+	}
+}
+
+func TestReplayBlocks(t *testing.T) {
+	Convey("TestReplayBlocks", t, func() {
+		logger := log.NewNopLogger()
+		src := makeBlockSource(5)
+
+		ps := NewPlugins(logger)
+		acked := newBlockPlugin("acked", nil)
+		fresh := newBlockPlugin("fresh", nil)
+		So(ps.RegPlugin(NewContext(logger), acked, QueueCfg{}), ShouldBeNil)
+		So(ps.RegPlugin(NewContext(logger), fresh, QueueCfg{}), ShouldBeNil)
+		So(ps.Start(), ShouldBeNil)
+
+		ps.runners[0].resetAcked(3)
+		So(ps.replayBlocks(src, 5), ShouldBeNil)
+		ps.Stop(NewContext(logger))
+
+		// plugins acked nothing will not be replayed
+		So(acked.heights, ShouldResemble, []int64{4, 5})
+		So(fresh.heights, ShouldBeEmpty)
+
+		So(acked.results, ShouldHaveLength, 2)
+		So(acked.results[1].GasUsed, ShouldEqual, 5)
+		So(acked.results[1].Tx.Memo, ShouldEqual, "tx")
+		So(acked.ctxs[1].ChainID(), ShouldEqual, "testchain")
+	})
+}
+
+func TestBackfillBlocks(t *testing.T) {
+	Convey("TestBackfillBlocks", t, func() {
+		logger := log.NewNopLogger()
+		src := makeBlockSource(5)
+		progressDB := dbm.NewMemDB()
+		progress := newAckStore(progressDB)
+
+		ps := NewPlugins(logger)
+		plugin := newBlockPlugin("backfill", nil)
+		So(ps.RegPlugin(NewContext(logger), plugin, QueueCfg{}), ShouldBeNil)
+		So(ps.Start(), ShouldBeNil)
+		ps.runners[0].resetAcked(5)
+
+		So(ps.backfillBlocks(src, progress, 1, 3, 2), ShouldBeNil)
+		So(plugin.heights, ShouldResemble, []int64{1, 2, 3})
+
+		done, err := progress.get(backfillProgressKey("backfill", 1, 3))
+		So(err, ShouldBeNil)
+		So(done, ShouldEqual, 3)
+
+		// the backfill of the same range is done
+		So(ps.backfillBlocks(src, progress, 1, 3, 2), ShouldBeNil)
+		So(plugin.heights, ShouldHaveLength, 3)
+
+		// the progress of other ranges is not used
+		plugin.heights = nil
+		So(progress.set(backfillProgressKey("backfill", 2, 5), 3), ShouldBeNil)
+		So(ps.backfillBlocks(src, progress, 1, 5, 2), ShouldBeNil)
+		So(plugin.heights, ShouldResemble, []int64{1, 2, 3, 4, 5})
+
+		// resumed from the progress of the same range
+		plugin.heights = nil
+		So(ps.backfillBlocks(src, progress, 2, 5, 2), ShouldBeNil)
+		So(plugin.heights, ShouldResemble, []int64{4, 5})
+
+		So(ResetBackfillProgress(progressDB, []string{"backfill"}, 2, 5), ShouldBeNil)
+		plugin.heights = nil
+		So(ps.backfillBlocks(src, progress, 2, 5, 2), ShouldBeNil)
+		So(plugin.heights, ShouldResemble, []int64{2, 3, 4, 5})
+
+		So(ps.backfillBlocks(src, progress, 1, 5, 0), ShouldNotBeNil)
+		ps.Stop(NewContext(logger))
+	})
+
+	Convey("TestBackfillBlocksByPlugin", t, func() {
+		logger := log.NewNopLogger()
+		src := makeBlockSource(5)
+		progress := newAckStore(dbm.NewMemDB())
+
+		ps := NewPlugins(logger)
+		resumed := newBlockPlugin("resumed", nil)
+		fresh := newBlockPlugin("fresh", nil)
+		So(ps.RegPlugin(NewContext(logger), resumed, QueueCfg{}), ShouldBeNil)
+		So(ps.RegPlugin(NewContext(logger), fresh, QueueCfg{}), ShouldBeNil)
+		So(ps.Start(), ShouldBeNil)
+
+		// the progress of a plugin is not used by others
+		So(progress.set(backfillProgressKey("resumed", 1, 5), 3), ShouldBeNil)
+		So(ps.backfillBlocks(src, progress, 1, 5, 2), ShouldBeNil)
+		ps.Stop(NewContext(logger))
+
+		So(resumed.heights, ShouldResemble, []int64{4, 5})
+		So(fresh.heights, ShouldResemble, []int64{1, 2, 3, 4, 5})
+	})
+}
@@ -0,0 +1,122 @@
+package plugins
+
+import (
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/plugins/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+// blockState tracks the tx in delivering in the current block
+type blockState struct {
+	ctx     types.Context
+	txIndex int
+
+	// events emitted by each msg of the tx in delivering
+	msgEvents []sdk.Events
+
+	// events emitted by the genesis, passed with the events of the first block
+	genesisEvents []types.Event
+}
+
+func (b *blockState) begin(ctx types.Context) {
+	b.ctx = ctx
+	b.txIndex = -1
+	b.msgEvents = nil
+}
+
+// nextTx moves to the next tx delivered, returns its context and the events of its msgs
+func (b *blockState) nextTx(txBytes []byte) (types.Context, []sdk.Events) {
+	msgEvents := b.msgEvents
+
+	b.txIndex++
+	b.msgEvents = nil
+
+	return b.ctx.WithTx(tmhash.Sum(txBytes), b.txIndex), msgEvents
+}
+
+// inBlock returns if a block has begun, txs delivered out of blocks are the gentxs in the genesis
+func (b *blockState) inBlock() bool {
+	return b.ctx.BlockHeight() > 0
+}
+
+// HandleInitChain plugins collect the events emitted by the genesis, such as the coins of the
+// genesis accounts, the events will be passed to plugins with the events of the first block
+func HandleInitChain(ctx sdk.Context) {
+	if plugins == nil {
+		return
+	}
+
+	plugins.block.genesisEvents = append(plugins.block.genesisEvents, types.FromSdkEvents(ctx.EventManager().Events())...)
+}
+
+// HandleBeginBlock plugins handler the begin of a block, it should be called by the last begin
+// blocker, so the events emitted by the begin blockers of other modules are passed to plugins
+func HandleBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
+	if plugins == nil {
+		return
+	}
+
+	pluginCtx := types.NewCtx(ctx)
+	plugins.block.begin(pluginCtx)
+	plugins.EmitBeginBlock(pluginCtx, req.Header)
+
+	events := append(plugins.block.genesisEvents, types.FromSdkEvents(ctx.EventManager().Events())...)
+	plugins.block.genesisEvents = nil
+	plugins.EmitBlockEvents(pluginCtx, events)
+}
+
+// HandleEvent plugins collect the events of a msg in delivering, the events will be
+// passed to plugins with the result of the tx
+func HandleEvent(ctx sdk.Context, evts sdk.Events) {
+	if plugins == nil || ctx.IsCheckTx() {
+		return
+	}
+
+	plugins.block.msgEvents = append(plugins.block.msgEvents, evts)
+}
+
+// HandleDeliverTx plugins handler the result of a tx delivered
+func HandleDeliverTx(txDecoder sdk.TxDecoder, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) {
+	if plugins == nil {
+		return
+	}
+
+	if !plugins.block.inBlock() {
+		plugins.block.msgEvents = nil
+		plugins.block.genesisEvents = append(plugins.block.genesisEvents, types.FromABCIEvents(res.Events)...)
+		return
+	}
+
+	pluginCtx, msgEvents := plugins.block.nextTx(req.Tx)
+
+	var stdTx chainTypes.StdTx
+	if tx, err := txDecoder(req.Tx); err == nil {
+		stdTx, _ = tx.(chainTypes.StdTx)
+	}
+
+	plugins.EmitTxResult(pluginCtx, types.NewTxResult(stdTx, res, msgEvents))
+}
+
+// HandleEndBlock plugins handler the end of a block, it should be called by the last end blocker,
+// so the events emitted by the end blockers of other modules are passed to plugins
+func HandleEndBlock(ctx sdk.Context, req abci.RequestEndBlock) {
+	if plugins == nil {
+		return
+	}
+
+	pluginCtx := types.NewCtx(ctx)
+	plugins.EmitBlockEvents(pluginCtx, types.FromSdkEvents(ctx.EventManager().Events()))
+	plugins.EmitEndBlock(pluginCtx, req)
+}
+
+// HandleCommit plugins handler the commit of a block, after which the block is final
+func HandleCommit(height int64, appHash []byte) {
+	if plugins == nil {
+		return
+	}
+
+	ctx := plugins.block.ctx.WithBlockHeight(height)
+	plugins.EmitCommit(ctx, appHash)
+}
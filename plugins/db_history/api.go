@@ -0,0 +1,270 @@
+package dbHistory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/plugins/db_history/chaindb"
+	"github.com/KuChainNetwork/kuchain/plugins/db_history/config"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+const apiShutdownTimeout = 5 * time.Second
+
+// historyQuerier queries the history data served by the api, implemented by chaindb.Storage
+type historyQuerier interface {
+	SyncState() (*chaindb.SyncState, error)
+	AccountTransfers(account, symbol string, page chaindb.Page) ([]chaindb.KuTransferInDB, int, error)
+	AccountBalances(account string, height int64) ([]chaindb.BalanceInDB, error)
+	BalanceHistory(account, symbol string, page chaindb.Page) ([]chaindb.BalanceChangeInDB, int, error)
+	CoinHolders(symbol string, page chaindb.Page) ([]chaindb.HolderInDB, int, error)
+}
+
+// pageResponse the response of paginated queries
+type pageResponse struct {
+	Total int         `json:"total"`
+	Page  int         `json:"page"`
+	Limit int         `json:"limit"`
+	Items interface{} `json:"items"`
+}
+
+// apiServer serves the read-only http api of history data. Note the balances are summed by the
+// balance deltas of the coin events, the genesis coins are only included if the history is synced
+// from the genesis, as the genesis events are not replayed by backfills.
+type apiServer struct {
+	cfg     config.APICfg
+	querier historyQuerier
+	logger  log.Logger
+	server  *http.Server
+}
+
+func newAPIServer(cfg config.APICfg, querier historyQuerier, logger log.Logger) *apiServer {
+	s := &apiServer{
+		cfg:     cfg.WithDefault(),
+		querier: querier,
+		logger:  logger,
+	}
+
+	s.server = &http.Server{
+		Handler: s.router(),
+	}
+
+	return s
+}
+
+func (s *apiServer) router() *mux.Router {
+	r := mux.NewRouter()
+
+	r.HandleFunc("/status", s.statusHandler).Methods("GET")
+	r.HandleFunc("/accounts/{account}/transfers", s.transfersHandler).Methods("GET")
+	r.HandleFunc("/accounts/{account}/balances", s.balancesHandler).Methods("GET")
+	r.HandleFunc("/accounts/{account}/balances/history", s.balanceHistoryHandler).Methods("GET")
+	r.HandleFunc("/holders", s.holdersHandler).Methods("GET")
+
+	return r
+}
+
+// Start listens the address and serves in a goroutine
+func (s *apiServer) Start() error {
+	listener, err := net.Listen("tcp", s.cfg.Address)
+	if err != nil {
+		return err
+	}
+
+	s.logger.Info("Starting history api", "address", listener.Addr())
+
+	go func() {
+		if err := s.server.Serve(listener); err != nil && err != http.ErrServerClosed {
+			s.logger.Error("history api stopped", "err", err)
+		}
+	}()
+
+	return nil
+}
+
+func (s *apiServer) Stop() error {
+	ctx, cancel := context.WithTimeout(context.Background(), apiShutdownTimeout)
+	defer cancel()
+
+	return s.server.Shutdown(ctx)
+}
+
+func (s *apiServer) statusHandler(w http.ResponseWriter, r *http.Request) {
+	stat, err := s.querier.SyncState()
+	if err != nil {
+		s.writeQueryError(w, err)
+		return
+	}
+
+	writeJSON(w, stat)
+}
+
+func (s *apiServer) transfersHandler(w http.ResponseWriter, r *http.Request) {
+	account, ok := parseAccount(w, r)
+	if !ok {
+		return
+	}
+
+	page, ok := s.parsePage(w, r)
+	if !ok {
+		return
+	}
+
+	transfers, total, err := s.querier.AccountTransfers(account, r.FormValue("symbol"), page)
+	if err != nil {
+		s.writeQueryError(w, err)
+		return
+	}
+
+	writeJSON(w, pageResponse{Total: total, Page: page.Page, Limit: page.Limit, Items: transfers})
+}
+
+func (s *apiServer) balancesHandler(w http.ResponseWriter, r *http.Request) {
+	account, ok := parseAccount(w, r)
+	if !ok {
+		return
+	}
+
+	var height int64
+	if heightStr := r.FormValue("height"); heightStr != "" {
+		if height, ok = rest.ParseInt64OrReturnBadRequest(w, heightStr); !ok {
+			return
+		}
+
+		if height <= 0 {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "height must greater than 0")
+			return
+		}
+	}
+
+	balances, err := s.querier.AccountBalances(account, height)
+	if err != nil {
+		s.writeQueryError(w, err)
+		return
+	}
+
+	writeJSON(w, balances)
+}
+
+func (s *apiServer) balanceHistoryHandler(w http.ResponseWriter, r *http.Request) {
+	account, ok := parseAccount(w, r)
+	if !ok {
+		return
+	}
+
+	symbol, ok := parseSymbol(w, r)
+	if !ok {
+		return
+	}
+
+	page, ok := s.parsePage(w, r)
+	if !ok {
+		return
+	}
+
+	changes, total, err := s.querier.BalanceHistory(account, symbol, page)
+	if err != nil {
+		s.writeQueryError(w, err)
+		return
+	}
+
+	writeJSON(w, pageResponse{Total: total, Page: page.Page, Limit: page.Limit, Items: changes})
+}
+
+func (s *apiServer) holdersHandler(w http.ResponseWriter, r *http.Request) {
+	symbol, ok := parseSymbol(w, r)
+	if !ok {
+		return
+	}
+
+	page, ok := s.parsePage(w, r)
+	if !ok {
+		return
+	}
+
+	holders, total, err := s.querier.CoinHolders(symbol, page)
+	if err != nil {
+		s.writeQueryError(w, err)
+		return
+	}
+
+	writeJSON(w, pageResponse{Total: total, Page: page.Page, Limit: page.Limit, Items: holders})
+}
+
+// parsePage parses the page and limit, which default to the first page and the max limit,
+// the items skipped before the page cannot exceed the max offset
+func (s *apiServer) parsePage(w http.ResponseWriter, r *http.Request) (chaindb.Page, bool) {
+	page := chaindb.Page{Page: 1, Limit: s.cfg.MaxLimit}
+
+	for _, param := range []struct {
+		name  string
+		value *int
+	}{{"page", &page.Page}, {"limit", &page.Limit}} {
+		str := r.FormValue(param.name)
+		if str == "" {
+			continue
+		}
+
+		n, err := strconv.Atoi(str)
+		if err != nil || n <= 0 {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("%s must be a positive integer", param.name))
+			return page, false
+		}
+
+		*param.value = n
+	}
+
+	if page.Limit > s.cfg.MaxLimit {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("limit cannot exceed %d", s.cfg.MaxLimit))
+		return page, false
+	}
+
+	if page.Page-1 > s.cfg.MaxOffset/page.Limit {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("page cannot exceed %d", s.cfg.MaxOffset/page.Limit+1))
+		return page, false
+	}
+
+	return page, true
+}
+
+func (s *apiServer) writeQueryError(w http.ResponseWriter, err error) {
+	s.logger.Error("history api query error", "err", err)
+	rest.WriteErrorResponse(w, http.StatusInternalServerError, "query history data failed")
+}
+
+func parseAccount(w http.ResponseWriter, r *http.Request) (string, bool) {
+	account, err := chainTypes.NewAccountIDFromStr(mux.Vars(r)["account"])
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return "", false
+	}
+
+	return account.String(), true
+}
+
+// parseSymbol parses the symbol of coin, which is in query as it contains "/"
+func parseSymbol(w http.ResponseWriter, r *http.Request) (string, bool) {
+	symbol := r.FormValue("symbol")
+	if symbol == "" {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, "symbol is required")
+		return "", false
+	}
+
+	return symbol, true
+}
+
+func writeJSON(w http.ResponseWriter, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+	}
+}
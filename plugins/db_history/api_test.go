@@ -0,0 +1,113 @@
+package dbHistory
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/KuChainNetwork/kuchain/plugins/db_history/chaindb"
+	"github.com/KuChainNetwork/kuchain/plugins/db_history/config"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// fakeQuerier records the params of the last query
+type fakeQuerier struct {
+	account string
+	symbol  string
+	height  int64
+	page    chaindb.Page
+}
+
+func (q *fakeQuerier) SyncState() (*chaindb.SyncState, error) {
+	return &chaindb.SyncState{ID: chaindb.ChainIdx, BlockNum: 10, ChainID: "testing"}, nil
+}
+
+func (q *fakeQuerier) AccountTransfers(account, symbol string, page chaindb.Page) ([]chaindb.KuTransferInDB, int, error) {
+	q.account, q.symbol, q.page = account, symbol, page
+	return []chaindb.KuTransferInDB{{Height: 3, From: account, To: "bob", Amount: "100", Symbol: "kuchain/kcs"}}, 21, nil
+}
+
+func (q *fakeQuerier) AccountBalances(account string, height int64) ([]chaindb.BalanceInDB, error) {
+	q.account, q.height = account, height
+	return []chaindb.BalanceInDB{{Symbol: "kuchain/kcs", Balance: "100"}}, nil
+}
+
+func (q *fakeQuerier) BalanceHistory(account, symbol string, page chaindb.Page) ([]chaindb.BalanceChangeInDB, int, error) {
+	q.account, q.symbol, q.page = account, symbol, page
+	return []chaindb.BalanceChangeInDB{{Height: 3, Delta: "-100", Balance: "0"}}, 1, nil
+}
+
+func (q *fakeQuerier) CoinHolders(symbol string, page chaindb.Page) ([]chaindb.HolderInDB, int, error) {
+	q.symbol, q.page = symbol, page
+	return []chaindb.HolderInDB{{Account: "alice", Balance: "100"}}, 1, nil
+}
+
+func serveAPI(s *apiServer, url string, res interface{}) int {
+	req := httptest.NewRequest("GET", url, nil)
+	w := httptest.NewRecorder()
+	s.router().ServeHTTP(w, req)
+
+	if res != nil && w.Code == http.StatusOK {
+		if err := json.Unmarshal(w.Body.Bytes(), res); err != nil {
+			panic(err)
+		}
+	}
+
+	return w.Code
+}
+
+func TestHistoryAPI(t *testing.T) {
+	Convey("TestHistoryAPI", t, func() {
+		querier := &fakeQuerier{}
+		s := newAPIServer(config.APICfg{Address: "127.0.0.1:0", MaxLimit: 50}, querier, log.NewNopLogger())
+
+		var stat chaindb.SyncState
+		So(serveAPI(s, "/status", &stat), ShouldEqual, http.StatusOK)
+		So(stat.BlockNum, ShouldEqual, 10)
+
+		var transfers struct {
+			pageResponse
+			Items []chaindb.KuTransferInDB `json:"items"`
+		}
+		So(serveAPI(s, "/accounts/alice/transfers?symbol=kuchain/kcs&page=2&limit=10", &transfers), ShouldEqual, http.StatusOK)
+		So(querier.account, ShouldEqual, "alice")
+		So(querier.symbol, ShouldEqual, "kuchain/kcs")
+		So(querier.page, ShouldResemble, chaindb.Page{Page: 2, Limit: 10})
+		So(transfers.Total, ShouldEqual, 21)
+		So(transfers.Page, ShouldEqual, 2)
+		So(transfers.Items, ShouldHaveLength, 1)
+		So(transfers.Items[0].Amount, ShouldEqual, "100")
+
+		// default to the first page with the max limit
+		So(serveAPI(s, "/accounts/alice/transfers", nil), ShouldEqual, http.StatusOK)
+		So(querier.symbol, ShouldEqual, "")
+		So(querier.page, ShouldResemble, chaindb.Page{Page: 1, Limit: 50})
+
+		var balances []chaindb.BalanceInDB
+		So(serveAPI(s, "/accounts/alice/balances?height=5", &balances), ShouldEqual, http.StatusOK)
+		So(querier.height, ShouldEqual, 5)
+		So(balances, ShouldResemble, []chaindb.BalanceInDB{{Symbol: "kuchain/kcs", Balance: "100"}})
+
+		So(serveAPI(s, "/accounts/alice/balances/history?symbol=kuchain/kcs", nil), ShouldEqual, http.StatusOK)
+		So(querier.symbol, ShouldEqual, "kuchain/kcs")
+
+		So(serveAPI(s, "/holders?symbol=kuchain/kcs&limit=5", nil), ShouldEqual, http.StatusOK)
+		So(querier.page, ShouldResemble, chaindb.Page{Page: 1, Limit: 5})
+	})
+
+	Convey("TestHistoryAPIBadRequest", t, func() {
+		s := newAPIServer(config.APICfg{Address: "127.0.0.1:0", MaxLimit: 50}, &fakeQuerier{}, log.NewNopLogger())
+
+		So(serveAPI(s, "/accounts/Invalid!/transfers", nil), ShouldEqual, http.StatusBadRequest)
+		So(serveAPI(s, "/accounts/alice/transfers?page=0", nil), ShouldEqual, http.StatusBadRequest)
+		So(serveAPI(s, "/accounts/alice/transfers?limit=51", nil), ShouldEqual, http.StatusBadRequest)
+		So(serveAPI(s, "/accounts/alice/transfers?page=9223372036854775807&limit=50", nil), ShouldEqual, http.StatusBadRequest)
+		So(serveAPI(s, "/accounts/alice/transfers?page=202&limit=50", nil), ShouldEqual, http.StatusBadRequest)
+		So(serveAPI(s, "/accounts/alice/transfers?page=201&limit=50", nil), ShouldEqual, http.StatusOK)
+		So(serveAPI(s, "/accounts/alice/balances?height=abc", nil), ShouldEqual, http.StatusBadRequest)
+		So(serveAPI(s, "/accounts/alice/balances/history", nil), ShouldEqual, http.StatusBadRequest)
+		So(serveAPI(s, "/holders", nil), ShouldEqual, http.StatusBadRequest)
+	})
+}
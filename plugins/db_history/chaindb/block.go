@@ -0,0 +1,256 @@
+package chaindb
+
+import (
+	"encoding/json"
+	"fmt"
+
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/plugins/db_history/types"
+	assetTypes "github.com/KuChainNetwork/kuchain/x/asset/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// BlockData all data of a block, which are inserted to database in a transaction when the block committed
+type BlockData struct {
+	Block     BlockInDB
+	Txs       []*TxInDB
+	Messages  []*MessageInDB
+	Events    []*EventInDB
+	Transfers []*KuTransferInDB
+	Deltas    []*BalanceDeltaInDB
+}
+
+// NewBlockData creates the block data when the block begins
+func NewBlockData(ctx types.Context, header abci.Header) *BlockData {
+	return &BlockData{
+		Block: BlockInDB{
+			Height:   header.Height,
+			ChainID:  header.ChainID,
+			Time:     header.Time,
+			Proposer: sdk.ConsAddress(header.ProposerAddress).String(),
+		},
+	}
+}
+
+// AddTxResult adds a tx delivered in the block, msgs, events, transfers are only added for txs succeeded,
+// the balance deltas are added by the coin events of the tx, which include the fee paid by txs failed
+func (b *BlockData) AddTxResult(ctx types.Context, result types.TxResult) {
+	var (
+		logger  = ctx.Logger()
+		height  = b.Block.Height
+		txIndex = ctx.TxIndex()
+		txHash  = ctx.TxHash().String()
+		tx      = result.Tx
+		deltas  = newBalanceDeltas()
+	)
+
+	txInDB := &TxInDB{
+		Hash:      txHash,
+		Height:    height,
+		Index:     txIndex,
+		Fee:       tx.Fee.Amount.String(),
+		GasWanted: result.GasWanted,
+		GasUsed:   result.GasUsed,
+		Memo:      tx.Memo,
+		Code:      result.Code,
+		Codespace: result.Codespace,
+		Log:       result.Log,
+	}
+
+	if len(tx.Msgs) > 0 {
+		payer := tx.FeePayer()
+		txInDB.FeePayer = payer.String()
+
+		if raw, err := tx.PrettifyJSON(ctx.Codec()); err == nil {
+			txInDB.Raw = string(raw)
+		} else {
+			logger.Error("prettify tx error", "hash", txHash, "err", err)
+		}
+	}
+
+	b.Txs = append(b.Txs, txInDB)
+	b.Block.NumTxs++
+
+	if result.IsOK() {
+		for idx, msg := range tx.Msgs {
+			b.addMsg(ctx, txIndex, txHash, idx, msg)
+		}
+
+		for idx, evts := range result.MsgEvents {
+			for _, evt := range evts {
+				b.Events = append(b.Events, &EventInDB{
+					Height:     height,
+					TxIndex:    txIndex,
+					TxHash:     txHash,
+					MsgIndex:   idx,
+					Type:       evt.Type,
+					Attributes: evt.Attributes,
+				})
+			}
+		}
+	}
+
+	for _, evt := range result.Events {
+		if err := deltas.addEvent(evt); err != nil {
+			logger.Error("balance deltas by event error", "hash", txHash, "event", evt.Type, "err", err)
+		}
+	}
+
+	b.Deltas = append(b.Deltas, deltas.toDB(height, &txIndex, txHash)...)
+}
+
+// AddBlockEvent adds the balance deltas by an event emitted out of txs, by begin or end blockers,
+// such as rewards and coins minted, or by the genesis for the first block
+func (b *BlockData) AddBlockEvent(ctx types.Context, evt types.Event) {
+	deltas := newBalanceDeltas()
+	if err := deltas.addEvent(evt); err != nil {
+		ctx.Logger().Error("balance deltas by event error", "height", b.Block.Height, "event", evt.Type, "err", err)
+		return
+	}
+
+	b.Deltas = append(b.Deltas, deltas.toDB(b.Block.Height, nil, "")...)
+}
+
+func (b *BlockData) addMsg(ctx types.Context, txIndex int, txHash string, idx int, msg sdk.Msg) {
+	msgInDB := &MessageInDB{
+		Height:   b.Block.Height,
+		TxIndex:  txIndex,
+		TxHash:   txHash,
+		MsgIndex: idx,
+		Router:   msg.Route(),
+		Action:   msg.Type(),
+	}
+
+	if prettifier, ok := msg.(chainTypes.Prettifier); ok {
+		if raw, err := prettifier.PrettifyJSON(ctx.Codec()); err == nil {
+			msgInDB.Raw = string(raw)
+			msgInDB.Data = msgDataFromRaw(raw)
+		} else {
+			ctx.Logger().Error("prettify msg error", "hash", txHash, "index", idx, "err", err)
+		}
+	}
+
+	b.Messages = append(b.Messages, msgInDB)
+
+	kuMsg, ok := msg.(chainTypes.KuTransfMsg)
+	if !ok {
+		return
+	}
+
+	for _, t := range kuMsg.GetTransfers() {
+		for _, amount := range t.Amount {
+			b.Transfers = append(b.Transfers, &KuTransferInDB{
+				Height:   b.Block.Height,
+				TxIndex:  txIndex,
+				TxHash:   txHash,
+				MsgIndex: idx,
+				Route:    kuMsg.Route(),
+				Type:     kuMsg.Type(),
+				From:     t.From.String(),
+				To:       t.To.String(),
+				Amount:   amount.Amount.String(),
+				Symbol:   amount.Denom,
+			})
+		}
+	}
+}
+
+// Commit sets the app hash after the block committed
+func (b *BlockData) Commit(appHash []byte) {
+	b.Block.AppHash = fmt.Sprintf("%X", appHash)
+}
+
+// msgDataFromRaw gets the decoded msg data from the prettified msg
+func msgDataFromRaw(raw []byte) string {
+	var msg struct {
+		Data json.RawMessage `json:"data"`
+	}
+
+	if err := json.Unmarshal(raw, &msg); err != nil || len(msg.Data) == 0 || string(msg.Data) == "null" {
+		return ""
+	}
+
+	return string(msg.Data)
+}
+
+type balanceKey struct {
+	account string
+	symbol  string
+}
+
+// balanceDeltas the changes of balances in a tx or an event, in the order of first changed
+type balanceDeltas struct {
+	keys   []balanceKey
+	deltas map[balanceKey]sdk.Int
+}
+
+func newBalanceDeltas() *balanceDeltas {
+	return &balanceDeltas{
+		deltas: make(map[balanceKey]sdk.Int),
+	}
+}
+
+func (d *balanceDeltas) change(account string, coins chainTypes.Coins, neg bool) {
+	for _, coin := range coins {
+		key := balanceKey{account: account, symbol: coin.Denom}
+
+		amount := coin.Amount
+		if neg {
+			amount = amount.Neg()
+		}
+
+		if old, ok := d.deltas[key]; ok {
+			d.deltas[key] = old.Add(amount)
+		} else {
+			d.keys = append(d.keys, key)
+			d.deltas[key] = amount
+		}
+	}
+}
+
+// addEvent adds the changes by the coin spent or received event emitted by the asset keeper,
+// other events are ignored
+func (d *balanceDeltas) addEvent(evt types.Event) error {
+	var account string
+
+	switch evt.Type {
+	case assetTypes.EventTypeCoinSpent:
+		account = evt.Attributes[assetTypes.AttributeKeySpender]
+	case assetTypes.EventTypeCoinReceived:
+		account = evt.Attributes[assetTypes.AttributeKeyReceiver]
+	default:
+		return nil
+	}
+
+	coins, err := chainTypes.ParseCoins(evt.Attributes[assetTypes.AttributeKeyAmount])
+	if err != nil {
+		return err
+	}
+
+	d.change(account, coins, evt.Type == assetTypes.EventTypeCoinSpent)
+	return nil
+}
+
+// toDB returns the deltas changed, txIndex is nil for the deltas not in a tx
+func (d *balanceDeltas) toDB(height int64, txIndex *int, txHash string) []*BalanceDeltaInDB {
+	res := make([]*BalanceDeltaInDB, 0, len(d.keys))
+
+	for _, key := range d.keys {
+		delta := d.deltas[key]
+		if delta.IsZero() {
+			continue
+		}
+
+		res = append(res, &BalanceDeltaInDB{
+			Height:  height,
+			TxIndex: txIndex,
+			TxHash:  txHash,
+			Account: key.account,
+			Symbol:  key.symbol,
+			Delta:   delta.String(),
+		})
+	}
+
+	return res
+}
@@ -0,0 +1,118 @@
+package chaindb
+
+import (
+	"testing"
+	"time"
+
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/plugins/db_history/types"
+	assetTypes "github.com/KuChainNetwork/kuchain/x/asset/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func makeTestCodec() *codec.Codec {
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	chainTypes.RegisterCodec(cdc)
+	assetTypes.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	return cdc
+}
+
+func TestBlockData(t *testing.T) {
+	Convey("TestBlockData", t, func() {
+		var (
+			alice  = chainTypes.MustAccountID("alice")
+			bob    = chainTypes.MustAccountID("bob")
+			symbol = "kuchain/kcs"
+			auth   = sdk.AccAddress("auth")
+
+			// larger than int64
+			amount = chainTypes.NewCoins(chainTypes.NewCoin(symbol, sdk.NewIntWithDecimal(1, 30)))
+			fee    = chainTypes.NewCoins(chainTypes.NewInt64Coin(symbol, 100))
+		)
+
+		ctx := types.NewContext(log.NewNopLogger()).WithCodec(makeTestCodec())
+		block := NewBlockData(ctx, abci.Header{Height: 10, ChainID: "testchain", Time: time.Now()})
+
+		transfer := assetTypes.NewMsgTransfer(auth, alice, bob, amount)
+		issue := assetTypes.NewMsgIssue(auth, chainTypes.MustName("kuchain"), chainTypes.MustName("kcs"),
+			chainTypes.NewInt64Coin(symbol, 1))
+
+		tx := chainTypes.StdTx{
+			Msgs: []sdk.Msg{transfer, issue},
+			Fee:  chainTypes.StdFee{Amount: fee, Payer: alice},
+			Memo: "memo",
+		}
+
+		coinSpent := func(account chainTypes.AccountID, coins chainTypes.Coins) types.Event {
+			return types.Event{Type: assetTypes.EventTypeCoinSpent, Attributes: map[string]string{
+				assetTypes.AttributeKeySpender: account.String(), assetTypes.AttributeKeyAmount: coins.String(),
+			}}
+		}
+		coinReceived := func(account chainTypes.AccountID, coins chainTypes.Coins) types.Event {
+			return types.Event{Type: assetTypes.EventTypeCoinReceived, Attributes: map[string]string{
+				assetTypes.AttributeKeyReceiver: account.String(), assetTypes.AttributeKeyAmount: coins.String(),
+			}}
+		}
+
+		// rewards minted by the begin blockers
+		block.AddBlockEvent(ctx, coinReceived(bob, fee))
+
+		block.AddTxResult(ctx.WithTx([]byte("tx0"), 0), types.TxResult{
+			Tx:        tx,
+			MsgEvents: [][]types.Event{{{Type: "transfer", Attributes: map[string]string{"from": "alice"}}}, {}},
+			Events:    []types.Event{coinSpent(alice, fee), coinSpent(alice, amount), coinReceived(bob, amount)},
+		})
+
+		// the msgs failed while the fee is paid
+		block.AddTxResult(ctx.WithTx([]byte("tx1"), 1), types.TxResult{
+			Tx:     tx,
+			Code:   5,
+			Events: []types.Event{coinSpent(alice, fee)},
+		})
+
+		// the ante handler failed, so no fee paid
+		block.AddTxResult(ctx.WithTx([]byte("tx2"), 2), types.TxResult{Tx: tx, Code: 4})
+		block.Commit([]byte{0xab})
+
+		So(block.Block.NumTxs, ShouldEqual, 3)
+		So(block.Block.AppHash, ShouldEqual, "AB")
+		So(block.Txs[0].Fee, ShouldEqual, fee.String())
+		So(block.Txs[0].FeePayer, ShouldEqual, "alice")
+		So(block.Txs[0].Raw, ShouldNotBeEmpty)
+		So(block.Txs[1].Code, ShouldEqual, 5)
+
+		// only the msgs of the tx succeeded
+		So(block.Messages, ShouldHaveLength, 2)
+		So(block.Messages[1].Action, ShouldEqual, "issue")
+		So(block.Messages[1].Data, ShouldContainSubstring, "asset/issueData")
+		So(block.Events, ShouldHaveLength, 1)
+		So(block.Events[0].TxHash, ShouldEqual, block.Txs[0].Hash)
+
+		So(block.Transfers, ShouldHaveLength, 1)
+		So(block.Transfers[0].Amount, ShouldEqual, "1000000000000000000000000000000")
+
+		So(block.Deltas, ShouldHaveLength, 4)
+
+		// the deltas out of txs have no tx
+		So(block.Deltas[0].Account, ShouldEqual, "bob")
+		So(block.Deltas[0].Delta, ShouldEqual, "100")
+		So(block.Deltas[0].TxIndex, ShouldBeNil)
+		So(block.Deltas[0].TxHash, ShouldBeEmpty)
+
+		// alice pays the fee for the first two txs, while transfer only in the first
+		So(block.Deltas[1].Account, ShouldEqual, "alice")
+		So(block.Deltas[1].Delta, ShouldEqual, "-1000000000000000000000000000100")
+		So(*block.Deltas[1].TxIndex, ShouldEqual, 0)
+		So(block.Deltas[2].Account, ShouldEqual, "bob")
+		So(block.Deltas[2].Delta, ShouldEqual, "1000000000000000000000000000000")
+		So(block.Deltas[3].Delta, ShouldEqual, "-100")
+		So(block.Deltas[3].TxHash, ShouldEqual, block.Txs[1].Hash)
+		So(*block.Deltas[3].TxIndex, ShouldEqual, 1)
+	})
+}
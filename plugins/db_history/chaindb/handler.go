@@ -3,29 +3,76 @@ package chaindb
 import (
 	"reflect"
 
-	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
-	"github.com/KuChainNetwork/kuchain/plugins/test/types"
-	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/go-pg/pg/v10"
+	"github.com/go-pg/pg/v10/orm"
+	"github.com/pkg/errors"
 	"github.com/tendermint/tendermint/libs/log"
 )
 
-func Process(db *pg.DB, logger log.Logger, msg interface{}) error {
-	logger.Debug("process msg", "typ", reflect.TypeOf(msg), "msg", msg)
+func Process(storage Storage, logger log.Logger, msg interface{}) error {
+	logger.Debug("process msg", "typ", reflect.TypeOf(msg))
+
 	switch msg := msg.(type) {
-	case types.Event:
-		return InsertEvent(db, logger, &msg)
-	case chainTypes.StdTx:
-		return insert(db, newTxInDB(msg))
+	case *BlockData:
+		return storage.InsertBlock(logger, msg)
 	}
 
-	if msg, ok := msg.(sdk.Msg); ok {
-		return processMsg(db, msg)
+	return nil
+}
+
+// InsertBlock inserts all data of a block and updates the sync state in a transaction,
+// so the data of blocks before the sync state are all in database, a block inserted
+// will be skipped.
+func InsertBlock(db *pg.DB, logger log.Logger, block *BlockData) error {
+	return db.RunInTransaction(func(tx *pg.Tx) error {
+		exists, err := tx.Model((*BlockInDB)(nil)).Where("height = ?", block.Block.Height).Exists()
+		if err != nil {
+			return errors.Wrapf(err, "check block %d", block.Block.Height)
+		}
+
+		if exists {
+			logger.Info("block already in database", "height", block.Block.Height)
+			return nil
+		}
+
+		if err := tx.Insert(&block.Block); err != nil {
+			return errors.Wrapf(err, "insert block %d", block.Block.Height)
+		}
+
+		for _, rows := range []interface{}{
+			&block.Txs, &block.Messages, &block.Events, &block.Transfers, &block.Deltas,
+		} {
+			if err := insertRows(tx, rows); err != nil {
+				return errors.Wrapf(err, "insert block %d", block.Block.Height)
+			}
+		}
+
+		return UpdateSyncState(tx, block.Block.Height, block.Block.ChainID)
+	})
+}
+
+// insertRows inserts rows in a slice by one statement
+func insertRows(tx *pg.Tx, rows interface{}) error {
+	if reflect.ValueOf(rows).Elem().Len() == 0 {
+		return nil
 	}
 
-	return nil
+	_, err := tx.Model(rows).Insert()
+	return err
 }
 
-func insert(db *pg.DB, obj interface{}) error {
-	return db.Insert(obj)
+// UpdateSyncState updates the height of the last block synced, the height never goes back,
+// so blocks backfilled will not reset it
+func UpdateSyncState(db orm.DB, num int64, chainID string) error {
+	_, err := db.Model(&SyncState{
+		ID:       ChainIdx,
+		BlockNum: num,
+		ChainID:  chainID,
+	}).
+		OnConflict("(id) DO UPDATE").
+		Set("block_num = GREATEST(sync_stat.block_num, EXCLUDED.block_num)").
+		Set("chain_id = EXCLUDED.chain_id").
+		Insert()
+
+	return errors.Wrapf(err, "update sync stat err")
 }
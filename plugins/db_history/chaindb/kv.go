@@ -0,0 +1,455 @@
+package chaindb
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// prefixes of keys in kv storage, strings in keys are terminated by kvSep, heights and
+// sequences are in big endian, so the keys are ordered by them in iteration.
+const (
+	kvSyncStatePrefix byte = iota + 1
+	kvBlockPrefix          // height
+	kvTxPrefix             // height, index
+	kvMsgPrefix            // height, seq
+	kvEventPrefix          // height, seq
+	kvTransferPrefix       // account, height, seq, indexed for both from and to
+	kvDeltaPrefix          // account, symbol, height, seq
+	kvBalancePrefix        // account, symbol, the latest balance, summed by all deltas
+	kvHolderPrefix         // symbol, account, the latest balance, summed by all deltas
+
+	kvSep byte = 0
+)
+
+// kvKey builds a key by the prefix and parts, which are strings, int64 or int
+func kvKey(prefix byte, parts ...interface{}) []byte {
+	key := []byte{prefix}
+
+	for _, part := range parts {
+		switch part := part.(type) {
+		case string:
+			key = append(append(key, part...), kvSep)
+		case int64:
+			key = append(key, sdk.Uint64ToBigEndian(uint64(part))...)
+		case int:
+			key = append(key, sdk.Uint64ToBigEndian(uint64(part))...)
+		default:
+			panic(fmt.Sprintf("unknown key part type %T", part))
+		}
+	}
+
+	return key
+}
+
+// kvKeyString returns the string part of the key after the prefix
+func kvKeyString(key, prefix []byte) string {
+	str := key[len(prefix):]
+	if idx := bytes.IndexByte(str, kvSep); idx >= 0 {
+		str = str[:idx]
+	}
+
+	return string(str)
+}
+
+// kvStorage stores the history data in an embedded key-value database, which needs no external
+// service. The rows are the same as in postgres, encoded in json and indexed by the keys of the
+// columns queried. Only the latest balances are summed when inserted, which do not depend on the
+// order of blocks inserted, as blocks may be backfilled after later ones, the balances at a height
+// are summed by the deltas in queries. Queries with total numbers iterate all rows of the account
+// or coin, which is fine for a local node.
+type kvStorage struct {
+	db dbm.DB
+}
+
+// NewKVStorage creates the kv storage in dir
+func NewKVStorage(dir string) (Storage, error) {
+	db, err := dbm.NewGoLevelDB("history", dir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "open history database in %s", dir)
+	}
+
+	return newKVStorage(db), nil
+}
+
+func newKVStorage(db dbm.DB) *kvStorage {
+	return &kvStorage{
+		db: db,
+	}
+}
+
+// Migrate does nothing as the rows are encoded in json, new fields are zero in old rows
+func (s *kvStorage) Migrate(logger log.Logger) error {
+	return nil
+}
+
+func (s *kvStorage) InsertBlock(logger log.Logger, block *BlockData) error {
+	height := block.Block.Height
+
+	exists, err := s.db.Has(kvKey(kvBlockPrefix, height))
+	if err != nil {
+		return errors.Wrapf(err, "check block %d", height)
+	}
+
+	if exists {
+		logger.Info("block already in database", "height", height)
+		return nil
+	}
+
+	stat, err := s.SyncState()
+	if err != nil {
+		return err
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	set := func(key []byte, row interface{}) {
+		if err == nil {
+			var bz []byte
+			if bz, err = json.Marshal(row); err == nil {
+				batch.Set(key, bz)
+			}
+		}
+	}
+
+	set(kvKey(kvBlockPrefix, height), block.Block)
+
+	for _, tx := range block.Txs {
+		set(kvKey(kvTxPrefix, tx.Height, tx.Index), tx)
+	}
+
+	for seq, msg := range block.Messages {
+		set(kvKey(kvMsgPrefix, height, seq), msg)
+	}
+
+	for seq, evt := range block.Events {
+		set(kvKey(kvEventPrefix, height, seq), evt)
+	}
+
+	for seq, transfer := range block.Transfers {
+		set(kvKey(kvTransferPrefix, transfer.From, height, seq), transfer)
+		if transfer.To != transfer.From {
+			set(kvKey(kvTransferPrefix, transfer.To, height, seq), transfer)
+		}
+	}
+
+	if err == nil {
+		err = s.insertDeltas(batch, block.Deltas, set)
+	}
+
+	if stat.BlockNum < height {
+		stat.BlockNum = height
+	}
+	stat.ChainID = block.Block.ChainID
+	set(kvKey(kvSyncStatePrefix), stat)
+
+	if err != nil {
+		return errors.Wrapf(err, "insert block %d", height)
+	}
+
+	return errors.Wrapf(batch.WriteSync(), "insert block %d", height)
+}
+
+// insertDeltas inserts the balance changes, and adds them to the latest balances
+func (s *kvStorage) insertDeltas(batch dbm.Batch, deltas []*BalanceDeltaInDB, set func(key []byte, row interface{})) error {
+	type balanceKey struct {
+		account string
+		symbol  string
+	}
+
+	var (
+		keys     []balanceKey
+		balances = make(map[balanceKey]sdk.Int)
+	)
+
+	for seq, delta := range deltas {
+		key := balanceKey{account: delta.Account, symbol: delta.Symbol}
+
+		balance, ok := balances[key]
+		if !ok {
+			var err error
+			if balance, err = s.balance(delta.Account, delta.Symbol); err != nil {
+				return err
+			}
+			keys = append(keys, key)
+		}
+
+		amount, ok := sdk.NewIntFromString(delta.Delta)
+		if !ok {
+			return fmt.Errorf("invalid balance delta %s of %s", delta.Delta, delta.Account)
+		}
+
+		balance = balance.Add(amount)
+		balances[key] = balance
+
+		set(kvKey(kvDeltaPrefix, delta.Account, delta.Symbol, delta.Height, seq), BalanceChangeInDB{
+			Height: delta.Height,
+			TxHash: delta.TxHash,
+			Delta:  delta.Delta,
+		})
+	}
+
+	for _, key := range keys {
+		balance := []byte(balances[key].String())
+		batch.Set(kvKey(kvBalancePrefix, key.account, key.symbol), balance)
+		batch.Set(kvKey(kvHolderPrefix, key.symbol, key.account), balance)
+	}
+
+	return nil
+}
+
+// balance returns the latest balance of the coin of the account
+func (s *kvStorage) balance(account, symbol string) (sdk.Int, error) {
+	bz, err := s.db.Get(kvKey(kvBalancePrefix, account, symbol))
+	if err != nil || len(bz) == 0 {
+		return sdk.ZeroInt(), err
+	}
+
+	balance, ok := sdk.NewIntFromString(string(bz))
+	if !ok {
+		return sdk.ZeroInt(), fmt.Errorf("invalid balance %s of %s", bz, account)
+	}
+
+	return balance, nil
+}
+
+func (s *kvStorage) SyncState() (*SyncState, error) {
+	stat := &SyncState{
+		ID: ChainIdx,
+	}
+
+	bz, err := s.db.Get(kvKey(kvSyncStatePrefix))
+	if err != nil {
+		return nil, errors.Wrapf(err, "get sync stat err")
+	}
+
+	if len(bz) == 0 {
+		return stat, nil
+	}
+
+	return stat, errors.Wrapf(json.Unmarshal(bz, stat), "get sync stat err")
+}
+
+// iteratePage iterates rows with the prefix in reverse order, rows in the page are unmarshaled
+// by onRow, returns the total number of rows matched by filter, which matches all if nil.
+func (s *kvStorage) iteratePage(prefix []byte, page Page, filter func(value []byte) bool, onRow func(value []byte) error) (int, error) {
+	it, err := s.db.ReverseIterator(prefix, sdk.PrefixEndBytes(prefix))
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+
+	total := 0
+	for ; it.Valid(); it.Next() {
+		if filter != nil && !filter(it.Value()) {
+			continue
+		}
+
+		if total >= page.Offset() && total < page.Offset()+page.Limit {
+			if err := onRow(it.Value()); err != nil {
+				return 0, err
+			}
+		}
+		total++
+	}
+
+	return total, it.Error()
+}
+
+func (s *kvStorage) AccountTransfers(account, symbol string, page Page) ([]KuTransferInDB, int, error) {
+	var (
+		transfers []KuTransferInDB
+		filter    func(value []byte) bool
+	)
+
+	if symbol != "" {
+		filter = func(value []byte) bool {
+			var transfer KuTransferInDB
+			return json.Unmarshal(value, &transfer) == nil && transfer.Symbol == symbol
+		}
+	}
+
+	total, err := s.iteratePage(kvKey(kvTransferPrefix, account), page, filter, func(value []byte) error {
+		var transfer KuTransferInDB
+		if err := json.Unmarshal(value, &transfer); err != nil {
+			return err
+		}
+
+		transfers = append(transfers, transfer)
+		return nil
+	})
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "query transfers of %s", account)
+	}
+
+	return transfers, total, nil
+}
+
+func (s *kvStorage) AccountBalances(account string, height int64) ([]BalanceInDB, error) {
+	prefix := kvKey(kvBalancePrefix, account)
+
+	it, err := s.db.Iterator(prefix, sdk.PrefixEndBytes(prefix))
+	if err != nil {
+		return nil, errors.Wrapf(err, "query balances of %s", account)
+	}
+	defer it.Close()
+
+	var balances []BalanceInDB
+	for ; it.Valid(); it.Next() {
+		balance := BalanceInDB{
+			Symbol:  kvKeyString(it.Key(), prefix),
+			Balance: string(it.Value()),
+		}
+
+		if height > 0 {
+			amount, changed, err := s.balanceAt(account, balance.Symbol, height)
+			if err != nil {
+				return nil, errors.Wrapf(err, "query balances of %s", account)
+			}
+
+			// no change before the height
+			if !changed {
+				continue
+			}
+
+			balance.Balance = amount.String()
+		}
+
+		balances = append(balances, balance)
+	}
+
+	return balances, errors.Wrapf(it.Error(), "query balances of %s", account)
+}
+
+// balanceAt returns the balance summed by the changes at or before the height, and if there are changes
+func (s *kvStorage) balanceAt(account, symbol string, height int64) (sdk.Int, bool, error) {
+	balance := sdk.ZeroInt()
+
+	it, err := s.db.Iterator(kvKey(kvDeltaPrefix, account, symbol), kvKey(kvDeltaPrefix, account, symbol, height+1))
+	if err != nil {
+		return balance, false, err
+	}
+	defer it.Close()
+
+	changed := false
+	for ; it.Valid(); it.Next() {
+		_, delta, err := unmarshalChange(it.Value())
+		if err != nil {
+			return balance, false, err
+		}
+
+		balance = balance.Add(delta)
+		changed = true
+	}
+
+	return balance, changed, it.Error()
+}
+
+// BalanceHistory returns the changes latest first, the balance after each change is the latest balance
+// minus the changes after it, so it does not depend on the order of blocks inserted
+func (s *kvStorage) BalanceHistory(account, symbol string, page Page) ([]BalanceChangeInDB, int, error) {
+	balance, err := s.balance(account, symbol)
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "query balance changes of %s", account)
+	}
+
+	prefix := kvKey(kvDeltaPrefix, account, symbol)
+
+	it, err := s.db.ReverseIterator(prefix, sdk.PrefixEndBytes(prefix))
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "query balance changes of %s", account)
+	}
+	defer it.Close()
+
+	var changes []BalanceChangeInDB
+
+	total := 0
+	for ; it.Valid(); it.Next() {
+		change, delta, err := unmarshalChange(it.Value())
+		if err != nil {
+			return nil, 0, errors.Wrapf(err, "query balance changes of %s", account)
+		}
+
+		if total >= page.Offset() && total < page.Offset()+page.Limit {
+			change.Balance = balance.String()
+			changes = append(changes, change)
+		}
+
+		balance = balance.Sub(delta)
+		total++
+	}
+
+	return changes, total, errors.Wrapf(it.Error(), "query balance changes of %s", account)
+}
+
+// unmarshalChange unmarshals a balance change row and its delta
+func unmarshalChange(value []byte) (BalanceChangeInDB, sdk.Int, error) {
+	var change BalanceChangeInDB
+	if err := json.Unmarshal(value, &change); err != nil {
+		return change, sdk.Int{}, err
+	}
+
+	delta, ok := sdk.NewIntFromString(change.Delta)
+	if !ok {
+		return change, sdk.Int{}, fmt.Errorf("invalid balance delta %s", change.Delta)
+	}
+
+	return change, delta, nil
+}
+
+func (s *kvStorage) CoinHolders(symbol string, page Page) ([]HolderInDB, int, error) {
+	prefix := kvKey(kvHolderPrefix, symbol)
+
+	it, err := s.db.Iterator(prefix, sdk.PrefixEndBytes(prefix))
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "query holders of %s", symbol)
+	}
+	defer it.Close()
+
+	type holder struct {
+		account string
+		balance sdk.Int
+	}
+
+	var holders []holder
+	for ; it.Valid(); it.Next() {
+		balance, ok := sdk.NewIntFromString(string(it.Value()))
+		if !ok {
+			return nil, 0, fmt.Errorf("invalid balance %s of holder of %s", it.Value(), symbol)
+		}
+
+		if balance.IsPositive() {
+			holders = append(holders, holder{account: kvKeyString(it.Key(), prefix), balance: balance})
+		}
+	}
+
+	if err := it.Error(); err != nil {
+		return nil, 0, errors.Wrapf(err, "query holders of %s", symbol)
+	}
+
+	// the same order as postgres, by balance desc then account
+	sort.SliceStable(holders, func(i, j int) bool {
+		if !holders[i].balance.Equal(holders[j].balance) {
+			return holders[i].balance.GT(holders[j].balance)
+		}
+		return holders[i].account < holders[j].account
+	})
+
+	var res []HolderInDB
+	for i := page.Offset(); i < len(holders) && i < page.Offset()+page.Limit; i++ {
+		res = append(res, HolderInDB{Account: holders[i].account, Balance: holders[i].balance.String()})
+	}
+
+	return res, len(holders), nil
+}
+
+func (s *kvStorage) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,203 @@
+package chaindb
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/KuChainNetwork/kuchain/plugins/db_history/config"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+)
+
+const testSymbol = "kuchain/kcs"
+
+type testTransfer struct {
+	from, to, symbol, amount string
+}
+
+// makeTransfersBlock makes a block with a tx of the transfers, and the balance deltas by them
+func makeTransfersBlock(height int64, transfers ...testTransfer) *BlockData {
+	txHash := string(rune('A' + height))
+	block := &BlockData{
+		Block: BlockInDB{Height: height, ChainID: "testing", NumTxs: 1},
+		Txs:   []*TxInDB{{Hash: txHash, Height: height}},
+	}
+
+	for _, t := range transfers {
+		block.Transfers = append(block.Transfers, &KuTransferInDB{
+			Height: height, TxHash: txHash, From: t.from, To: t.to, Amount: t.amount, Symbol: t.symbol,
+		})
+		block.Deltas = append(block.Deltas,
+			&BalanceDeltaInDB{Height: height, TxHash: txHash, Account: t.from, Symbol: t.symbol, Delta: "-" + t.amount},
+			&BalanceDeltaInDB{Height: height, TxHash: txHash, Account: t.to, Symbol: t.symbol, Delta: t.amount})
+	}
+
+	return block
+}
+
+func TestKVStorage(t *testing.T) {
+	Convey("TestKVStorage", t, func() {
+		var (
+			logger  = log.NewNopLogger()
+			storage = newKVStorage(dbm.NewMemDB())
+		)
+
+		So(storage.Migrate(logger), ShouldBeNil)
+
+		stat, err := storage.SyncState()
+		So(err, ShouldBeNil)
+		So(stat.BlockNum, ShouldEqual, 0)
+
+		blocks := []*BlockData{
+			makeTransfersBlock(1, testTransfer{"kuchain", "alice", testSymbol, "1000"}),
+			makeTransfersBlock(2,
+				testTransfer{"alice", "bob", testSymbol, "300"},
+				testTransfer{"kuchain", "alice", "foo/bar", "5"}),
+			makeTransfersBlock(3, testTransfer{"bob", "alice", testSymbol, "100"}),
+		}
+
+		for _, block := range blocks {
+			So(storage.InsertBlock(logger, block), ShouldBeNil)
+		}
+
+		// blocks inserted are skipped
+		So(storage.InsertBlock(logger, blocks[1]), ShouldBeNil)
+
+		stat, err = storage.SyncState()
+		So(err, ShouldBeNil)
+		So(stat.BlockNum, ShouldEqual, 3)
+		So(stat.ChainID, ShouldEqual, "testing")
+
+		transfers, total, err := storage.AccountTransfers("alice", "", Page{Page: 1, Limit: 10})
+		So(err, ShouldBeNil)
+		So(total, ShouldEqual, 4)
+		So(transfers, ShouldHaveLength, 4)
+		So(transfers[0].Height, ShouldEqual, 3)
+		So(transfers[3].Height, ShouldEqual, 1)
+
+		transfers, total, err = storage.AccountTransfers("alice", testSymbol, Page{Page: 2, Limit: 2})
+		So(err, ShouldBeNil)
+		So(total, ShouldEqual, 3)
+		So(transfers, ShouldHaveLength, 1)
+		So(transfers[0].Amount, ShouldEqual, "1000")
+
+		balances, err := storage.AccountBalances("alice", 0)
+		So(err, ShouldBeNil)
+		So(balances, ShouldResemble, []BalanceInDB{{Symbol: "foo/bar", Balance: "5"}, {Symbol: testSymbol, Balance: "800"}})
+
+		balances, err = storage.AccountBalances("alice", 1)
+		So(err, ShouldBeNil)
+		So(balances, ShouldResemble, []BalanceInDB{{Symbol: testSymbol, Balance: "1000"}})
+
+		balances, err = storage.AccountBalances("alice", 2)
+		So(err, ShouldBeNil)
+		So(balances, ShouldResemble, []BalanceInDB{{Symbol: "foo/bar", Balance: "5"}, {Symbol: testSymbol, Balance: "700"}})
+
+		changes, total, err := storage.BalanceHistory("alice", testSymbol, Page{Page: 1, Limit: 10})
+		So(err, ShouldBeNil)
+		So(total, ShouldEqual, 3)
+		So(changes[0], ShouldResemble, BalanceChangeInDB{Height: 3, TxHash: "D", Delta: "100", Balance: "800"})
+		So(changes[1].Balance, ShouldEqual, "700")
+		So(changes[2].Balance, ShouldEqual, "1000")
+
+		holders, total, err := storage.CoinHolders(testSymbol, Page{Page: 1, Limit: 10})
+		So(err, ShouldBeNil)
+		So(total, ShouldEqual, 2)
+		So(holders, ShouldResemble, []HolderInDB{{Account: "alice", Balance: "800"}, {Account: "bob", Balance: "200"}})
+
+		// a tx delivered again in a later block is stored with both blocks
+		rebroadcast := makeTransfersBlock(4)
+		rebroadcast.Txs[0].Hash = blocks[2].Txs[0].Hash
+		So(storage.InsertBlock(logger, rebroadcast), ShouldBeNil)
+
+		for _, height := range []int64{3, 4} {
+			exists, err := storage.db.Has(kvKey(kvTxPrefix, height, 0))
+			So(err, ShouldBeNil)
+			So(exists, ShouldBeTrue)
+		}
+
+		So(storage.Close(), ShouldBeNil)
+	})
+
+	Convey("TestKVStorageOutOfOrder", t, func() {
+		var (
+			logger  = log.NewNopLogger()
+			storage = newKVStorage(dbm.NewMemDB())
+		)
+
+		// the blocks before 3 are backfilled after it
+		for _, block := range []*BlockData{
+			makeTransfersBlock(3, testTransfer{"bob", "alice", testSymbol, "100"}),
+			makeTransfersBlock(1, testTransfer{"kuchain", "alice", testSymbol, "1000"}),
+			makeTransfersBlock(2, testTransfer{"alice", "bob", testSymbol, "300"}),
+		} {
+			So(storage.InsertBlock(logger, block), ShouldBeNil)
+		}
+
+		stat, err := storage.SyncState()
+		So(err, ShouldBeNil)
+		So(stat.BlockNum, ShouldEqual, 3)
+
+		// the same as the blocks inserted in order
+		changes, total, err := storage.BalanceHistory("alice", testSymbol, Page{Page: 1, Limit: 10})
+		So(err, ShouldBeNil)
+		So(total, ShouldEqual, 3)
+		So(changes, ShouldResemble, []BalanceChangeInDB{
+			{Height: 3, TxHash: "D", Delta: "100", Balance: "800"},
+			{Height: 2, TxHash: "C", Delta: "-300", Balance: "700"},
+			{Height: 1, TxHash: "B", Delta: "1000", Balance: "1000"},
+		})
+
+		changes, total, err = storage.BalanceHistory("alice", testSymbol, Page{Page: 2, Limit: 2})
+		So(err, ShouldBeNil)
+		So(total, ShouldEqual, 3)
+		So(changes, ShouldResemble, []BalanceChangeInDB{{Height: 1, TxHash: "B", Delta: "1000", Balance: "1000"}})
+
+		for height, balance := range map[int64]string{1: "1000", 2: "700", 3: "800", 0: "800"} {
+			balances, err := storage.AccountBalances("alice", height)
+			So(err, ShouldBeNil)
+			So(balances, ShouldResemble, []BalanceInDB{{Symbol: testSymbol, Balance: balance}})
+		}
+
+		balances, err := storage.AccountBalances("bob", 1)
+		So(err, ShouldBeNil)
+		So(balances, ShouldBeEmpty)
+
+		holders, total, err := storage.CoinHolders(testSymbol, Page{Page: 1, Limit: 10})
+		So(err, ShouldBeNil)
+		So(total, ShouldEqual, 2)
+		So(holders, ShouldResemble, []HolderInDB{{Account: "alice", Balance: "800"}, {Account: "bob", Balance: "200"}})
+
+		So(storage.Close(), ShouldBeNil)
+	})
+
+	Convey("TestKVStorageReopen", t, func() {
+		dir, err := ioutil.TempDir("", "history")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+
+		cfg := config.DBCfg{Backend: config.BackendKV, Dir: dir}
+		So(cfg.Validate(), ShouldBeNil)
+
+		storage, err := NewStorage(cfg)
+		So(err, ShouldBeNil)
+		So(storage.InsertBlock(log.NewNopLogger(), makeTransfersBlock(1, testTransfer{"kuchain", "alice", testSymbol, "1000"})), ShouldBeNil)
+		So(storage.Close(), ShouldBeNil)
+
+		storage, err = NewStorage(cfg)
+		So(err, ShouldBeNil)
+		defer storage.Close()
+
+		stat, err := storage.SyncState()
+		So(err, ShouldBeNil)
+		So(stat.BlockNum, ShouldEqual, 1)
+
+		// balances continue from the data persisted
+		So(storage.InsertBlock(log.NewNopLogger(), makeTransfersBlock(2, testTransfer{"alice", "bob", testSymbol, "1"})), ShouldBeNil)
+		balances, err := storage.AccountBalances("alice", 0)
+		So(err, ShouldBeNil)
+		So(balances, ShouldResemble, []BalanceInDB{{Symbol: testSymbol, Balance: "999"}})
+	})
+}
@@ -0,0 +1,242 @@
+package chaindb
+
+import (
+	"time"
+
+	"github.com/go-pg/pg/v10"
+	"github.com/pkg/errors"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// migration a version of the database schema, migrations are applied in order of versions,
+// each in a transaction, a applied migration should never be changed, add a new one instead.
+type migration struct {
+	Version int
+	Name    string
+	Up      []string
+}
+
+// schemaMigration the migration applied in database
+type schemaMigration struct {
+	tableName struct{} `pg:"schema_migrations,alias:schema_migrations"` // default values are the same
+
+	Version   int `pg:",pk"`
+	Name      string
+	AppliedAt time.Time
+}
+
+// legacySchema the schema the tables created by the old createSchema are archived to
+const legacySchema = "db_history_legacy"
+
+// legacyTables the tables created by the old createSchema
+var legacyTables = []string{"events", "tx", "messages", "transfer", "sync_stat"}
+
+// archiveLegacyTables returns the statements to move the legacy tables to the legacy schema
+func archiveLegacyTables() []string {
+	stmts := []string{`CREATE SCHEMA IF NOT EXISTS ` + legacySchema}
+	for _, table := range legacyTables {
+		stmts = append(stmts, `ALTER TABLE IF EXISTS `+table+` SET SCHEMA `+legacySchema)
+	}
+
+	return stmts
+}
+
+var migrations = []migration{
+	{
+		Version: 1,
+		Name:    "normalized schema for blocks, txs, messages, transfers and balances",
+		// tables created by the old createSchema, the data in them cannot be linked to blocks,
+		// so they are archived to the legacy schema with their indexes to free the names
+		Up: append(archiveLegacyTables(),
+			`CREATE TABLE blocks (
+				height   bigint PRIMARY KEY,
+				chain_id text NOT NULL,
+				time     timestamptz NOT NULL,
+				proposer text,
+				num_txs  integer NOT NULL,
+				app_hash text
+			)`,
+
+			`CREATE TABLE txs (
+				hash       text PRIMARY KEY,
+				height     bigint NOT NULL REFERENCES blocks (height),
+				index      integer NOT NULL,
+				fee        text,
+				fee_payer  text,
+				gas_wanted bigint NOT NULL,
+				gas_used   bigint NOT NULL,
+				memo       text,
+				code       bigint NOT NULL,
+				codespace  text,
+				log        text,
+				raw        jsonb
+			)`,
+			`CREATE INDEX txs_height_idx ON txs (height)`,
+			`CREATE INDEX txs_fee_payer_idx ON txs (fee_payer)`,
+
+			`CREATE TABLE messages (
+				id        bigserial PRIMARY KEY,
+				height    bigint NOT NULL,
+				tx_hash   text NOT NULL REFERENCES txs (hash),
+				msg_index integer NOT NULL,
+				router    text NOT NULL,
+				action    text NOT NULL,
+				data      jsonb,
+				raw       jsonb,
+				UNIQUE (tx_hash, msg_index)
+			)`,
+			`CREATE INDEX messages_height_idx ON messages (height)`,
+			`CREATE INDEX messages_router_action_idx ON messages (router, action)`,
+
+			`CREATE TABLE events (
+				id         bigserial PRIMARY KEY,
+				height     bigint NOT NULL,
+				tx_hash    text NOT NULL REFERENCES txs (hash),
+				msg_index  integer NOT NULL,
+				type       text NOT NULL,
+				attributes jsonb
+			)`,
+			`CREATE INDEX events_tx_hash_idx ON events (tx_hash)`,
+			`CREATE INDEX events_type_idx ON events (type)`,
+
+			`CREATE TABLE transfers (
+				id        bigserial PRIMARY KEY,
+				height    bigint NOT NULL,
+				tx_hash   text NOT NULL REFERENCES txs (hash),
+				msg_index integer NOT NULL,
+				route     text,
+				type      text,
+				"from"    text NOT NULL,
+				"to"      text NOT NULL,
+				amount    numeric NOT NULL,
+				symbol    text NOT NULL
+			)`,
+			`CREATE INDEX transfers_from_idx ON transfers ("from", height)`,
+			`CREATE INDEX transfers_to_idx ON transfers ("to", height)`,
+
+			`CREATE TABLE balance_deltas (
+				id      bigserial PRIMARY KEY,
+				height  bigint NOT NULL,
+				tx_hash text NOT NULL REFERENCES txs (hash),
+				account text NOT NULL,
+				symbol  text NOT NULL,
+				delta   numeric NOT NULL
+			)`,
+			`CREATE INDEX balance_deltas_account_idx ON balance_deltas (account, symbol, height)`,
+
+			`CREATE TABLE sync_stat (
+				id        integer PRIMARY KEY,
+				block_num bigint NOT NULL,
+				chain_id  text UNIQUE
+			)`,
+		),
+	},
+	{
+		Version: 2,
+		Name:    "index balance deltas by symbol for holder rankings",
+		Up: []string{
+			`CREATE INDEX balance_deltas_symbol_idx ON balance_deltas (symbol, account)`,
+		},
+	},
+	{
+		Version: 3,
+		Name:    "key txs by height and index as a tx can be delivered in more than one block",
+		Up: []string{
+			`ALTER TABLE messages ADD COLUMN tx_index integer`,
+			`ALTER TABLE events ADD COLUMN tx_index integer`,
+			`ALTER TABLE transfers ADD COLUMN tx_index integer`,
+			`ALTER TABLE balance_deltas ADD COLUMN tx_index integer`,
+
+			`UPDATE messages SET tx_index = txs.index FROM txs WHERE txs.hash = messages.tx_hash`,
+			`UPDATE events SET tx_index = txs.index FROM txs WHERE txs.hash = events.tx_hash`,
+			`UPDATE transfers SET tx_index = txs.index FROM txs WHERE txs.hash = transfers.tx_hash`,
+			`UPDATE balance_deltas SET tx_index = txs.index FROM txs WHERE txs.hash = balance_deltas.tx_hash`,
+
+			`ALTER TABLE messages DROP CONSTRAINT messages_tx_hash_fkey, DROP CONSTRAINT messages_tx_hash_msg_index_key`,
+			`ALTER TABLE events DROP CONSTRAINT events_tx_hash_fkey`,
+			`ALTER TABLE transfers DROP CONSTRAINT transfers_tx_hash_fkey`,
+			`ALTER TABLE balance_deltas DROP CONSTRAINT balance_deltas_tx_hash_fkey`,
+
+			`ALTER TABLE txs DROP CONSTRAINT txs_pkey, ADD PRIMARY KEY (height, index)`,
+			`CREATE INDEX txs_hash_idx ON txs (hash)`,
+
+			`ALTER TABLE messages ALTER COLUMN tx_index SET NOT NULL,
+				ADD FOREIGN KEY (height, tx_index) REFERENCES txs (height, index),
+				ADD UNIQUE (height, tx_index, msg_index)`,
+			`ALTER TABLE events ALTER COLUMN tx_index SET NOT NULL,
+				ADD FOREIGN KEY (height, tx_index) REFERENCES txs (height, index)`,
+			`ALTER TABLE transfers ALTER COLUMN tx_index SET NOT NULL,
+				ADD FOREIGN KEY (height, tx_index) REFERENCES txs (height, index)`,
+			`ALTER TABLE balance_deltas ALTER COLUMN tx_index SET NOT NULL,
+				ADD FOREIGN KEY (height, tx_index) REFERENCES txs (height, index)`,
+		},
+	},
+	{
+		Version: 4,
+		Name:    "balance deltas by begin and end blockers, which are not in txs",
+		Up: []string{
+			`ALTER TABLE balance_deltas ALTER COLUMN tx_hash DROP NOT NULL, ALTER COLUMN tx_index DROP NOT NULL`,
+		},
+	},
+}
+
+// Migrate applies the migrations not applied to database
+func Migrate(db *pg.DB, logger log.Logger) error {
+	if _, err := db.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+		version    integer PRIMARY KEY,
+		name       text,
+		applied_at timestamptz
+	)`); err != nil {
+		return errors.Wrap(err, "create schema migrations table")
+	}
+
+	var applied []schemaMigration
+	if err := db.Model(&applied).Select(); err != nil {
+		return errors.Wrap(err, "get applied migrations")
+	}
+
+	appliedVersions := make(map[int]bool, len(applied))
+	for _, m := range applied {
+		appliedVersions[m.Version] = true
+	}
+
+	if !appliedVersions[1] {
+		var tables []string
+		if _, err := db.Query(&tables, `SELECT table_name FROM information_schema.tables
+			WHERE table_schema = current_schema() AND table_name IN (?)`, pg.In(legacyTables)); err != nil {
+			return errors.Wrap(err, "get legacy tables")
+		}
+
+		if len(tables) > 0 {
+			logger.Info("archive legacy tables, the data in them are kept but not used", "tables", tables, "schema", legacySchema)
+		}
+	}
+
+	for _, m := range migrations {
+		if appliedVersions[m.Version] {
+			continue
+		}
+
+		logger.Info("apply migration", "version", m.Version, "name", m.Name)
+
+		m := m
+		err := db.RunInTransaction(func(tx *pg.Tx) error {
+			for _, stmt := range m.Up {
+				if _, err := tx.Exec(stmt); err != nil {
+					return err
+				}
+			}
+
+			return tx.Insert(&schemaMigration{
+				Version:   m.Version,
+				Name:      m.Name,
+				AppliedAt: time.Now().UTC(),
+			})
+		})
+		if err != nil {
+			return errors.Wrapf(err, "apply migration %d", m.Version)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package chaindb
+
+import (
+	"time"
+)
+
+// BlockInDB a block, inserted with all its txs when committed
+type BlockInDB struct {
+	tableName struct{} `pg:"blocks,alias:blocks"` // default values are the same
+
+	Height   int64 `pg:",pk"`
+	ChainID  string
+	Time     time.Time
+	Proposer string
+	NumTxs   int `pg:",use_zero"`
+	AppHash  string
+}
+
+// TxInDB a tx delivered in a block, failed txs are included with the code and log,
+// txs are keyed by the height and index as a tx can be delivered in more than one block
+type TxInDB struct {
+	tableName struct{} `pg:"txs,alias:txs"` // default values are the same
+
+	Height    int64 `pg:",pk"`
+	Index     int   `pg:",pk,use_zero"`
+	Hash      string
+	Fee       string
+	FeePayer  string
+	GasWanted int64 `pg:",use_zero"`
+	GasUsed   int64 `pg:",use_zero"`
+	Memo      string
+	Code      uint32 `pg:",use_zero"`
+	Codespace string
+	Log       string
+	Raw       string `pg:"type:jsonb"`
+}
+
+// MessageInDB a msg in a tx succeeded
+type MessageInDB struct {
+	tableName struct{} `pg:"messages,alias:messages"` // default values are the same
+
+	ID       int64 // both "Id" and "ID" are detected as primary key
+	Height   int64
+	TxIndex  int `pg:",use_zero"`
+	TxHash   string
+	MsgIndex int `pg:",use_zero"`
+	Router   string
+	Action   string
+	Data     string `pg:"type:jsonb"`
+	Raw      string `pg:"type:jsonb"`
+}
+
+// EventInDB an event emitted by a msg
+type EventInDB struct {
+	tableName struct{} `pg:"events,alias:events"` // default values are the same
+
+	ID         int64 // both "Id" and "ID" are detected as primary key
+	Height     int64
+	TxIndex    int `pg:",use_zero"`
+	TxHash     string
+	MsgIndex   int `pg:",use_zero"`
+	Type       string
+	Attributes map[string]string `pg:"type:jsonb"`
+}
+
+// KuTransferInDB a transfer of a coin in a msg, amount is a decimal string as it may exceed int64
+type KuTransferInDB struct {
+	tableName struct{} `pg:"transfers,alias:transfers"` // default values are the same
+
+	ID       int64  `json:"-"` // both "Id" and "ID" are detected as primary key
+	Height   int64  `json:"height"`
+	TxIndex  int    `pg:",use_zero" json:"tx_index"`
+	TxHash   string `json:"tx_hash"`
+	MsgIndex int    `pg:",use_zero" json:"msg_index"`
+	Route    string `json:"route"`
+	Type     string `json:"type"`
+	From     string `json:"from"`
+	To       string `json:"to"`
+	Amount   string `pg:"type:numeric" json:"amount"`
+	Symbol   string `json:"symbol"`
+}
+
+// BalanceDeltaInDB the change of the balance of a coin of an account by a tx, or by the begin and
+// end blockers, whose tx index and hash are null
+type BalanceDeltaInDB struct {
+	tableName struct{} `pg:"balance_deltas,alias:balance_deltas"` // default values are the same
+
+	ID      int64 // both "Id" and "ID" are detected as primary key
+	Height  int64
+	TxIndex *int
+	TxHash  string
+	Account string
+	Symbol  string
+	Delta   string `pg:"type:numeric"`
+}
+
+// ChainIdx the id of the sync state of chain
+const ChainIdx = 1
+
+// SyncState sync state in pg database
+type SyncState struct {
+	tableName struct{} `pg:"sync_stat,alias:sync_stat"` // default values are the same
+
+	ID       int    `json:"-"` // both "Id" and "ID" are detected as primary key
+	BlockNum int64  `json:"block_num"`
+	ChainID  string `pg:",unique" json:"chain_id"`
+}
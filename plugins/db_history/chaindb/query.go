@@ -0,0 +1,129 @@
+package chaindb
+
+import (
+	"github.com/go-pg/pg/v10"
+	"github.com/go-pg/pg/v10/orm"
+	"github.com/pkg/errors"
+)
+
+// Page the pagination of a query, pages start from 1
+type Page struct {
+	Page  int
+	Limit int
+}
+
+// Offset returns the number of rows skipped by the page
+func (p Page) Offset() int {
+	return (p.Page - 1) * p.Limit
+}
+
+// BalanceInDB the balance of a coin of an account, summed by the balance deltas
+type BalanceInDB struct {
+	Symbol  string `json:"symbol"`
+	Balance string `json:"balance"`
+}
+
+// BalanceChangeInDB a change of the balance of a coin of an account, with the balance after it
+type BalanceChangeInDB struct {
+	Height  int64  `json:"height"`
+	TxHash  string `json:"tx_hash"`
+	Delta   string `json:"delta"`
+	Balance string `json:"balance"`
+}
+
+// HolderInDB an account holding a coin
+type HolderInDB struct {
+	Account string `json:"account"`
+	Balance string `json:"balance"`
+}
+
+// QueryAccountTransfers returns the transfers from or to the account, latest first,
+// and the total number of them, symbol is optional to only query transfers of a coin.
+func QueryAccountTransfers(db orm.DB, account, symbol string, page Page) ([]KuTransferInDB, int, error) {
+	var transfers []KuTransferInDB
+
+	query := db.Model(&transfers).
+		WhereGroup(func(q *orm.Query) (*orm.Query, error) {
+			return q.Where(`"from" = ?`, account).WhereOr(`"to" = ?`, account), nil
+		})
+
+	if symbol != "" {
+		query = query.Where("symbol = ?", symbol)
+	}
+
+	total, err := query.
+		Order("height DESC", "id DESC").
+		Limit(page.Limit).
+		Offset(page.Offset()).
+		SelectAndCount()
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "query transfers of %s", account)
+	}
+
+	return transfers, total, nil
+}
+
+// QueryAccountBalances returns the balances of all coins of the account at the height,
+// the latest balances if height is 0
+func QueryAccountBalances(db orm.DB, account string, height int64) ([]BalanceInDB, error) {
+	var balances []BalanceInDB
+
+	// height 0 means no limit as heights start from 1
+	if _, err := db.Query(&balances, `
+		SELECT symbol, SUM(delta) AS balance FROM balance_deltas
+		WHERE account = ? AND (? = 0 OR height <= ?)
+		GROUP BY symbol ORDER BY symbol`,
+		account, height, height); err != nil {
+		return nil, errors.Wrapf(err, "query balances of %s", account)
+	}
+
+	return balances, nil
+}
+
+// QueryBalanceHistory returns the changes of the balance of a coin of the account, latest first,
+// and the total number of them
+func QueryBalanceHistory(db orm.DB, account, symbol string, page Page) ([]BalanceChangeInDB, int, error) {
+	total, err := db.Model((*BalanceDeltaInDB)(nil)).
+		Where("account = ?", account).
+		Where("symbol = ?", symbol).
+		Count()
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "count balance changes of %s", account)
+	}
+
+	var changes []BalanceChangeInDB
+	if _, err := db.Query(&changes, `
+		SELECT height, tx_hash, delta, balance FROM (
+			SELECT id, height, tx_hash, delta, SUM(delta) OVER (ORDER BY height, id) AS balance
+			FROM balance_deltas WHERE account = ? AND symbol = ?
+		) AS changes
+		ORDER BY height DESC, id DESC LIMIT ? OFFSET ?`,
+		account, symbol, page.Limit, page.Offset()); err != nil {
+		return nil, 0, errors.Wrapf(err, "query balance changes of %s", account)
+	}
+
+	return changes, total, nil
+}
+
+// QueryCoinHolders returns the accounts holding the coin, ordered by balance desc,
+// and the total number of them
+func QueryCoinHolders(db orm.DB, symbol string, page Page) ([]HolderInDB, int, error) {
+	var total int
+	if _, err := db.QueryOne(pg.Scan(&total), `
+		SELECT count(*) FROM (
+			SELECT account FROM balance_deltas WHERE symbol = ? GROUP BY account HAVING SUM(delta) > 0
+		) AS holders`, symbol); err != nil {
+		return nil, 0, errors.Wrapf(err, "count holders of %s", symbol)
+	}
+
+	var holders []HolderInDB
+	if _, err := db.Query(&holders, `
+		SELECT account, SUM(delta) AS balance FROM balance_deltas
+		WHERE symbol = ? GROUP BY account HAVING SUM(delta) > 0
+		ORDER BY SUM(delta) DESC, account LIMIT ? OFFSET ?`,
+		symbol, page.Limit, page.Offset()); err != nil {
+		return nil, 0, errors.Wrapf(err, "query holders of %s", symbol)
+	}
+
+	return holders, total, nil
+}
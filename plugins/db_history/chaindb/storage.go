@@ -0,0 +1,110 @@
+package chaindb
+
+import (
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/plugins/db_history/config"
+	"github.com/go-pg/pg/v10"
+	"github.com/go-pg/pg/v10/orm"
+	"github.com/pkg/errors"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// Storage stores the history data of chain, all backends share the same rows and queries
+type Storage interface {
+	// Migrate makes the storage ready for the current schema
+	Migrate(logger log.Logger) error
+
+	// InsertBlock inserts all data of a block and updates the sync state atomically,
+	// a block already inserted will be skipped
+	InsertBlock(logger log.Logger, block *BlockData) error
+
+	// SyncState returns the sync state of chain, with a zero block num if no state
+	SyncState() (*SyncState, error)
+
+	AccountTransfers(account, symbol string, page Page) ([]KuTransferInDB, int, error)
+	AccountBalances(account string, height int64) ([]BalanceInDB, error)
+	BalanceHistory(account, symbol string, page Page) ([]BalanceChangeInDB, int, error)
+	CoinHolders(symbol string, page Page) ([]HolderInDB, int, error)
+
+	Close() error
+}
+
+// NewStorage creates the storage by the backend in cfg
+func NewStorage(cfg config.DBCfg) (Storage, error) {
+	switch cfg.WithDefault().Backend {
+	case config.BackendPostgres:
+		return NewPgStorage(pg.Connect(&pg.Options{
+			Addr:     cfg.Address,
+			User:     cfg.User,
+			Password: cfg.Password,
+			Database: cfg.Database,
+		})), nil
+	case config.BackendKV:
+		return NewKVStorage(cfg.Dir)
+	default:
+		return nil, fmt.Errorf("unknown database backend %q", cfg.Backend)
+	}
+}
+
+// GetSyncState returns the sync state of chain, with a zero block num if no state
+func GetSyncState(db orm.DB) (*SyncState, error) {
+	stat := &SyncState{
+		ID: ChainIdx,
+	}
+
+	err := db.Model(stat).WherePK().Select()
+	if err == pg.ErrNoRows {
+		return stat, nil
+	}
+
+	if err != nil {
+		return nil, errors.Wrapf(err, "get sync stat err")
+	}
+
+	return stat, nil
+}
+
+// pgStorage stores the history data in postgres
+type pgStorage struct {
+	db *pg.DB
+}
+
+// NewPgStorage creates the storage by a postgres connection
+func NewPgStorage(db *pg.DB) Storage {
+	return &pgStorage{
+		db: db,
+	}
+}
+
+func (s *pgStorage) Migrate(logger log.Logger) error {
+	return Migrate(s.db, logger)
+}
+
+func (s *pgStorage) InsertBlock(logger log.Logger, block *BlockData) error {
+	return InsertBlock(s.db, logger, block)
+}
+
+func (s *pgStorage) SyncState() (*SyncState, error) {
+	return GetSyncState(s.db)
+}
+
+func (s *pgStorage) AccountTransfers(account, symbol string, page Page) ([]KuTransferInDB, int, error) {
+	return QueryAccountTransfers(s.db, account, symbol, page)
+}
+
+func (s *pgStorage) AccountBalances(account string, height int64) ([]BalanceInDB, error) {
+	return QueryAccountBalances(s.db, account, height)
+}
+
+func (s *pgStorage) BalanceHistory(account, symbol string, page Page) ([]BalanceChangeInDB, int, error) {
+	return QueryBalanceHistory(s.db, account, symbol, page)
+}
+
+func (s *pgStorage) CoinHolders(symbol string, page Page) ([]HolderInDB, int, error) {
+	return QueryCoinHolders(s.db, symbol, page)
+}
+
+func (s *pgStorage) Close() error {
+	return s.db.Close()
+}
@@ -1,13 +1,89 @@
 package config
 
+import "fmt"
+
+// Backends of the database to store history data
+const (
+	// BackendPostgres stores history data in an external postgres
+	BackendPostgres = "postgres"
+	// BackendKV stores history data in an embedded key-value database, which needs no external service
+	BackendKV = "kv"
+)
+
 // DBCfg cfg for database connect
 type DBCfg struct {
+	// Backend is the backend of database, postgres if empty
+	Backend string `json:"backend"`
+
+	// Address, User, Password and Database are for the postgres backend
 	Address  string `json:"address"`
 	User     string `json:"user"`
 	Password string `json:"password"`
 	Database string `json:"database"`
+
+	// Dir is the directory of database files for the kv backend
+	Dir string `json:"dir"`
+}
+
+// WithDefault returns the config with the default values for fields not set
+func (c DBCfg) WithDefault() DBCfg {
+	if c.Backend == "" {
+		c.Backend = BackendPostgres
+	}
+
+	return c
+}
+
+// Validate validates the config
+func (c DBCfg) Validate() error {
+	switch c.Backend {
+	case "", BackendPostgres:
+	case BackendKV:
+		if c.Dir == "" {
+			return fmt.Errorf("dir is required by the %s backend", BackendKV)
+		}
+	default:
+		return fmt.Errorf("unknown database backend %q", c.Backend)
+	}
+
+	return nil
+}
+
+// DefaultAPIMaxLimit the max number of items in a page of api if not set
+const DefaultAPIMaxLimit = 100
+
+// DefaultAPIMaxOffset the max number of items skipped before a page of api if not set
+const DefaultAPIMaxOffset = 10000
+
+// APICfg cfg for the read-only http api of history data
+type APICfg struct {
+	// Address is the address to listen, the api is disabled if empty
+	Address string `json:"address"`
+	// MaxLimit is the max number of items in a page
+	MaxLimit int `json:"max_limit"`
+	// MaxOffset is the max number of items skipped before a page, which bounds the deep scans
+	MaxOffset int `json:"max_offset"`
+}
+
+// Enabled returns if the api is enabled
+func (c APICfg) Enabled() bool {
+	return c.Address != ""
+}
+
+// WithDefault returns the config with the default values for fields not set
+func (c APICfg) WithDefault() APICfg {
+	if c.MaxLimit <= 0 {
+		c.MaxLimit = DefaultAPIMaxLimit
+	}
+
+	if c.MaxOffset <= 0 {
+		c.MaxOffset = DefaultAPIMaxOffset
+	}
+
+	return c
 }
 
 type Cfg struct {
-	DB DBCfg `json:"db"`
+	DB  DBCfg  `json:"db"`
+	API APICfg `json:"api"`
 }
@@ -1,77 +1,115 @@
 package dbHistory
 
 import (
+	"errors"
 	"sync"
+	"time"
 
 	"github.com/KuChainNetwork/kuchain/plugins/db_history/chaindb"
 	"github.com/KuChainNetwork/kuchain/plugins/db_history/config"
-	"github.com/go-pg/pg/v10"
 	"github.com/tendermint/tendermint/libs/log"
 )
 
+const (
+	// retry interval when process to database failed, doubled each retry up to maxRetryInterval
+	minRetryInterval = 500 * time.Millisecond
+	maxRetryInterval = 30 * time.Second
+)
+
 type dbWork struct {
 	msg interface{}
 }
 
-func (w dbWork) IsStopped() bool {
-	return w.msg == nil
+// flushWork is done after all works before it processed
+type flushWork struct {
+	done chan struct{}
 }
 
 type dbService struct {
-	logger   log.Logger
-	database *pg.DB
+	logger  log.Logger
+	storage chaindb.Storage
 
 	dbChan chan dbWork
+	quit   chan struct{}
 	wg     sync.WaitGroup
 }
 
 // NewDB create a connection commit event to db
-func NewDB(cfg config.Cfg, logger log.Logger) *dbService {
-	return &dbService{
-		database: pg.Connect(&pg.Options{
-			Addr:     cfg.DB.Address,
-			User:     cfg.DB.User,
-			Password: cfg.DB.Password,
-			Database: cfg.DB.Database,
-		}),
-		logger: logger,
-		dbChan: make(chan dbWork, 512),
+func NewDB(cfg config.Cfg, logger log.Logger) (*dbService, error) {
+	storage, err := chaindb.NewStorage(cfg.DB)
+	if err != nil {
+		return nil, err
 	}
+
+	return &dbService{
+		storage: storage,
+		logger:  logger,
+		dbChan:  make(chan dbWork, 512),
+		quit:    make(chan struct{}),
+	}, nil
 }
 
 func (db *dbService) Start() error {
 	db.logger.Info("Starting database service")
 
+	if err := db.storage.Migrate(db.logger); err != nil {
+		return err
+	}
+
 	db.wg.Add(1)
 	go func() {
 		defer db.wg.Done()
 
-		if err := createSchema(db.database); err != nil {
-			panic(err)
-		}
-
 		for {
 			work, ok := <-db.dbChan
 			if !ok {
-				db.logger.Info("msg channel closed")
-				return
-			}
-
-			if work.IsStopped() {
 				db.logger.Info("db service stopped")
 				return
 			}
 
-			if err := db.Process(&work); err != nil {
-				db.logger.Error("db process error", "err", err)
+			// works after a failed one are not processed, so the sync state will not
+			// pass the block failed, which will be replayed when restarted
+			if err := db.processWithRetry(&work); err != nil {
+				db.logger.Error("db process error, stop processing", "err", err)
+				return
 			}
 		}
 	}()
 	return nil
 }
 
+// processWithRetry process the work until succeed or the service is stopping,
+// so the data in flight will not be lost when database restarts
+func (db *dbService) processWithRetry(work *dbWork) error {
+	interval := minRetryInterval
+
+	for {
+		err := db.Process(work)
+		if err == nil {
+			return nil
+		}
+
+		db.logger.Error("db process error, retry", "err", err, "after", interval)
+
+		select {
+		case <-db.quit:
+			return err
+		case <-time.After(interval):
+		}
+
+		if interval *= 2; interval > maxRetryInterval {
+			interval = maxRetryInterval
+		}
+	}
+}
+
 func (db *dbService) Process(work *dbWork) error {
-	if err := chaindb.Process(db.database, db.logger, work.msg); err != nil {
+	if flush, ok := work.msg.(flushWork); ok {
+		close(flush.done)
+		return nil
+	}
+
+	if err := chaindb.Process(db.storage, db.logger, work.msg); err != nil {
 		return err
 	}
 
@@ -82,15 +120,36 @@ func (db *dbService) Emit(work dbWork) {
 	db.dbChan <- work
 }
 
+// Flush waits until all works emitted before are processed
+func (db *dbService) Flush() error {
+	flush := flushWork{
+		done: make(chan struct{}),
+	}
+
+	db.Emit(dbWork{
+		msg: flush,
+	})
+
+	select {
+	case <-flush.done:
+		return nil
+	case <-db.quit:
+		return errors.New("db service stopped")
+	}
+}
+
 func (db *dbService) Stop() error {
 	db.logger.Info("Stopping database service")
 
-	db.dbChan <- dbWork{}
+	close(db.quit)
+	close(db.dbChan)
 	db.wg.Wait()
 
 	db.logger.Info("Database service stopped")
 
-	db.database.Close()
+	if err := db.storage.Close(); err != nil {
+		db.logger.Error("close database error", "err", err)
+	}
 
 	db.logger.Info("Database connection closed")
 	return nil
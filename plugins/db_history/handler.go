@@ -1,31 +1,43 @@
 package dbHistory
 
 import (
-	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/plugins/db_history/chaindb"
 	"github.com/KuChainNetwork/kuchain/plugins/db_history/types"
-	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
-func (t *plugin) OnEvent(ctx types.Context, evt types.Event) {
-	t.logger.Info("on event", "type", evt.Type)
+func (t *plugin) OnBeginBlock(ctx types.Context, header abci.Header) {
+	t.block = chaindb.NewBlockData(ctx, header)
+}
 
-	t.db.Emit(dbWork{
-		msg: evt,
-	})
+func (t *plugin) OnTxResult(ctx types.Context, result types.TxResult) {
+	if t.block == nil {
+		t.logger.Error("tx result not in block", "height", ctx.BlockHeight(), "hash", ctx.TxHash())
+		return
+	}
+
+	t.block.AddTxResult(ctx, result)
 }
 
-func (t *plugin) OnTx(ctx types.Context, tx chainTypes.StdTx) {
-	t.logger.Info("on tx", "tx", tx)
+func (t *plugin) OnBlockEvent(ctx types.Context, evt types.Event) {
+	if t.block == nil {
+		t.logger.Error("event not in block", "height", ctx.BlockHeight(), "type", evt.Type)
+		return
+	}
 
-	t.db.Emit(dbWork{
-		msg: tx,
-	})
+	t.block.AddBlockEvent(ctx, evt)
 }
 
-func (t *plugin) OnMsg(ctx types.Context, msg sdk.Msg) {
-	t.logger.Info("on msg", "msg", msg)
+// OnCommit emits the block to database, which is inserted in a transaction with the sync state
+func (t *plugin) OnCommit(ctx types.Context, appHash []byte) {
+	if t.block == nil {
+		t.logger.Error("commit not in block", "height", ctx.BlockHeight())
+		return
+	}
 
+	t.block.Commit(appHash)
 	t.db.Emit(dbWork{
-		msg: msg,
+		msg: t.block,
 	})
+	t.block = nil
 }
@@ -4,10 +4,11 @@ import (
 	"encoding/json"
 	"fmt"
 
-	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/plugins"
+	"github.com/KuChainNetwork/kuchain/plugins/db_history/chaindb"
 	"github.com/KuChainNetwork/kuchain/plugins/db_history/config"
 	"github.com/KuChainNetwork/kuchain/plugins/db_history/types"
-	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 )
 
@@ -17,11 +18,28 @@ type plugin struct {
 
 	cfg config.Cfg
 	db  *dbService
+	api *apiServer
+
+	// block the data of the block in processing, only used in the goroutine of plugin
+	block *chaindb.BlockData
 }
 
 func (t *plugin) Init(ctx types.Context) error {
 	t.logger.Info("plugin init", "name", types.PluginName)
-	t.db = NewDB(t.cfg, ctx.Logger().With("module", "his-database"))
+	if err := t.cfg.DB.Validate(); err != nil {
+		return err
+	}
+
+	db, err := NewDB(t.cfg, ctx.Logger().With("module", "his-database"))
+	if err != nil {
+		return err
+	}
+	t.db = db
+
+	if t.cfg.API.Enabled() {
+		t.api = newAPIServer(t.cfg.API, db.storage, ctx.Logger().With("module", "his-api"))
+	}
+
 	return nil
 }
 
@@ -32,12 +50,24 @@ func (t *plugin) Start(ctx types.Context) error {
 		return err
 	}
 
+	if t.api != nil {
+		if err := t.api.Start(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
 func (t *plugin) Stop(ctx types.Context) error {
 	t.logger.Info("plugin stop", "name", types.PluginName)
 
+	if t.api != nil {
+		if err := t.api.Stop(); err != nil {
+			t.logger.Error("stop history api error", "err", err)
+		}
+	}
+
 	if err := t.db.Stop(); err != nil {
 		return err
 	}
@@ -46,23 +76,59 @@ func (t *plugin) Stop(ctx types.Context) error {
 }
 
 func (t *plugin) MsgHandler() types.PluginMsgHandler {
-	return func(ctx types.Context, msg sdk.Msg) {
-		t.OnMsg(ctx, msg)
-	}
+	return nil
 }
 
 func (t *plugin) TxHandler() types.PluginTxHandler {
-	return func(ctx types.Context, tx chainTypes.StdTx) {
-		t.OnTx(ctx, tx)
-	}
+	return nil
 }
 
+// EvtHandler handles the events out of txs, the events of txs are added with the tx results
 func (t *plugin) EvtHandler() types.PluginEvtHandler {
 	return func(ctx types.Context, evt types.Event) {
-		t.OnEvent(ctx, evt)
+		if ctx.TxIndex() < 0 {
+			t.OnBlockEvent(ctx, evt)
+		}
 	}
 }
 
+func (t *plugin) TxResultHandler() types.PluginTxResultHandler {
+	return func(ctx types.Context, result types.TxResult) {
+		t.OnTxResult(ctx, result)
+	}
+}
+
+func (t *plugin) BeginBlockHandler() types.PluginBeginBlockHandler {
+	return func(ctx types.Context, header abci.Header) {
+		t.OnBeginBlock(ctx, header)
+	}
+}
+
+func (t *plugin) EndBlockHandler() types.PluginEndBlockHandler {
+	return nil
+}
+
+func (t *plugin) CommitHandler() types.PluginCommitHandler {
+	return func(ctx types.Context, appHash []byte) {
+		t.OnCommit(ctx, appHash)
+	}
+}
+
+// Flush returns after all blocks handled are inserted to database
+func (t *plugin) Flush(ctx types.Context) error {
+	return t.db.Flush()
+}
+
+// LastAckedHeight returns the block num in sync state, which is updated after all data of the block inserted
+func (t *plugin) LastAckedHeight(ctx types.Context) (int64, error) {
+	stat, err := t.db.storage.SyncState()
+	if err != nil {
+		return 0, err
+	}
+
+	return stat.BlockNum, nil
+}
+
 func (t *plugin) Logger() log.Logger {
 	return t.logger
 }
@@ -87,3 +153,9 @@ func New(ctx types.Context, cfg types.BaseCfg) *plugin {
 
 	return res
 }
+
+func init() {
+	plugins.Register(types.PluginName, func(ctx plugins.Context, cfg plugins.BaseCfg) (plugins.Plugin, error) {
+		return New(ctx, cfg), nil
+	})
+}
@@ -10,10 +10,20 @@ import (
 type (
 	Context          = types.Context
 	Event            = types.Event
+	TxResult         = types.TxResult
 	BaseCfg          = types.BaseCfg
 	PluginMsgHandler = types.PluginMsgHandler
 	PluginTxHandler  = types.PluginTxHandler
 	PluginEvtHandler = types.PluginEvtHandler
+
+	PluginTxResultHandler   = types.PluginTxResultHandler
+	PluginBeginBlockHandler = types.PluginBeginBlockHandler
+	PluginEndBlockHandler   = types.PluginEndBlockHandler
+	PluginCommitHandler     = types.PluginCommitHandler
+)
+
+var (
+	NewContext = types.NewContext
 )
 
 func Logger(ctx Context) log.Logger {
@@ -1,132 +1,174 @@
 package plugins
 
 import (
-	"errors"
-	"sync"
+	"fmt"
+	"path/filepath"
 
 	"github.com/KuChainNetwork/kuchain/plugins/types"
-	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
 )
 
 type pluginMsg interface{}
 
+// Option option for plugins
+type Option func(*Plugins)
+
+// WithAckDB sets the db to persist the height of the last block acknowledged by each plugin,
+// the db is closed when plugins stopped
+func WithAckDB(db dbm.DB) Option {
+	return func(p *Plugins) {
+		p.acks = newAckStore(db)
+	}
+}
+
+// WithMetrics sets the metrics of plugins
+func WithMetrics(metrics *Metrics) Option {
+	return func(p *Plugins) {
+		p.metrics = metrics
+	}
+}
+
 // Plugins a handler for all plugins to reg
 type Plugins struct {
-	plugins     []Plugin
-	txHandlers  []types.PluginTxHandler
-	msgHandlers []types.PluginMsgHandler
-	evtHandlers []types.PluginEvtHandler
+	runners []*pluginRunner
 
-	msgChan chan pluginMsg
-	closed  bool
+	// state of the block in processing, only used by the consensus goroutine
+	block blockState
+
+	acks    *ackStore
+	metrics *Metrics
 	logger  log.Logger
-	wg      sync.WaitGroup
 }
 
-func NewPlugins(logger log.Logger) *Plugins {
-	return &Plugins{
-		msgChan: make(chan pluginMsg, 512),
-		closed:  false,
+func NewPlugins(logger log.Logger, options ...Option) *Plugins {
+	res := &Plugins{
+		metrics: NopMetrics(),
 		logger:  logger,
 	}
+
+	for _, opt := range options {
+		opt(res)
+	}
+
+	return res
 }
 
-func (p *Plugins) RegPlugin(ctx Context, plugin Plugin) {
+// RegPlugin inits the plugin and reg it with the config of its msg queue, the codec in ctx is passed
+// to the plugin in the context of each callback
+func (p *Plugins) RegPlugin(ctx Context, plugin Plugin, queueCfg types.QueueCfg) error {
 	plugin.Logger().Info("init plugin", "name", plugin.Name())
 
-	for _, p := range p.plugins {
-		if p.Name() == plugin.Name() {
-			panic(errors.New("plugin reg two times"))
+	for _, r := range p.runners {
+		if r.plugin.Name() == plugin.Name() {
+			return fmt.Errorf("plugin %s reg two times", plugin.Name())
 		}
 	}
 
-	if err := plugin.Init(ctx); err != nil {
-		panic(err)
+	queueCfg = queueCfg.WithDefault()
+	if err := queueCfg.Validate(); err != nil {
+		return err
 	}
 
-	p.plugins = append(p.plugins, plugin)
-
-	if tx := plugin.TxHandler(); tx != nil {
-		p.txHandlers = append(p.txHandlers, tx)
+	// the plugin acks the blocks with its own data, which cannot stop at the blocks with msgs dropped
+	if _, ok := plugin.(types.PluginAcker); ok && queueCfg.Overflow == types.OverflowDrop {
+		return fmt.Errorf("plugin %s acks blocks by itself, the %s overflow policy is not supported", plugin.Name(), types.OverflowDrop)
 	}
 
-	if msg := plugin.MsgHandler(); msg != nil {
-		p.msgHandlers = append(p.msgHandlers, msg)
+	if err := plugin.Init(ctx); err != nil {
+		return err
 	}
 
-	if evt := plugin.EvtHandler(); evt != nil {
-		p.evtHandlers = append(p.evtHandlers, evt)
+	var (
+		metrics = p.metrics.forPlugin(plugin.Name())
+		logger  = p.logger.With("plugin", plugin.Name())
+		spill   *spillStore
+	)
+
+	if queueCfg.Overflow == types.OverflowSpill {
+		if ctx.Codec() == nil {
+			return fmt.Errorf("plugin %s: no codec to spill msgs", plugin.Name())
+		}
+
+		var err error
+		if spill, err = newSpillStore(ctx.Codec(), plugin.Name(), filepath.Clean(queueCfg.SpillDir)); err != nil {
+			return err
+		}
 	}
+
+	queue := newMsgQueue(queueCfg, spill, metrics, logger)
+	p.runners = append(p.runners, newPluginRunner(ctx.Codec(), plugin, queue, p.acks, metrics, logger))
+
+	return nil
 }
 
-func (p *Plugins) onTx(ctx types.Context, tx StdTx) {
-	for _, h := range p.txHandlers {
-		h(ctx, tx)
-	}
+// Start starts all plugins, each plugin handles its msgs in its own goroutine
+func (p *Plugins) Start() error {
+	ctx := types.NewContext(p.logger)
+	for _, r := range p.runners {
+		if err := r.plugin.Start(ctx); err != nil {
+			return err
+		}
 
-	for _, h := range p.msgHandlers {
-		for _, msg := range tx.Msgs {
-			h(ctx, msg)
+		if err := r.loadAckedHeight(ctx); err != nil {
+			return fmt.Errorf("load acked height of plugin %s: %s", r.plugin.Name(), err.Error())
 		}
 	}
+
+	for _, r := range p.runners {
+		r.start()
+	}
+
+	return nil
 }
 
-func (p *Plugins) onEvent(ctx types.Context, evt types.Event) {
-	for _, h := range p.evtHandlers {
-		h(ctx, evt)
+func (p *Plugins) emit(ctx types.Context, msg pluginMsg) {
+	for _, r := range p.runners {
+		r.push(ctx, msg)
 	}
 }
 
-func (p *Plugins) Start() {
-	p.wg.Add(1)
-	go func() {
-		defer p.wg.Done()
+func (p *Plugins) EmitTxResult(ctx types.Context, result types.TxResult) {
+	p.emit(ctx, types.NewMsgTxResult(ctx, result))
+}
 
-		ctx := types.NewContext(p.logger)
-		for _, p := range p.plugins {
-			if err := p.Start(ctx); err != nil {
-				panic(err)
-			}
-		}
+func (p *Plugins) EmitBeginBlock(ctx types.Context, header abci.Header) {
+	p.emit(ctx, types.NewMsgBeginBlock(ctx, header))
+}
 
-		for {
-			msg, ok := <-p.msgChan
-			if !ok {
-				p.logger.Info("msg channel closed")
-				return
-			}
-
-			if msg == nil {
-				p.logger.Info("stop channel")
-				return
-			}
-
-			ctx := NewContext(p.logger)
-
-			switch msg := msg.(type) {
-			case *types.MsgEvent:
-				p.onEvent(ctx, msg.Evt)
-			case *types.MsgStdTx:
-				p.onTx(ctx, msg.Tx)
-			}
-		}
-	}()
+// EmitBlockEvents emits the events out of txs, nothing is emitted if no events
+func (p *Plugins) EmitBlockEvents(ctx types.Context, events []types.Event) {
+	if len(events) == 0 {
+		return
+	}
+
+	p.emit(ctx, types.NewMsgBlockEvents(ctx, events))
 }
 
-func (p *Plugins) EmitEvent(evt sdk.Event) {
-	p.msgChan <- types.NewMsgEvent(evt)
+func (p *Plugins) EmitEndBlock(ctx types.Context, req abci.RequestEndBlock) {
+	p.emit(ctx, types.NewMsgEndBlock(ctx, req))
 }
 
-func (p *Plugins) EmitTx(tx StdTx) {
-	p.msgChan <- types.NewMsgStdTx(tx)
+func (p *Plugins) EmitCommit(ctx types.Context, appHash []byte) {
+	p.emit(ctx, types.NewMsgCommit(ctx, appHash))
 }
 
+// Stop stops all plugins after the msgs in their queues are handled
 func (p *Plugins) Stop(ctx types.Context) {
-	p.msgChan <- nil
-	p.wg.Wait()
+	for _, r := range p.runners {
+		r.stop()
+	}
 
-	for _, plg := range p.plugins {
-		plg.Stop(ctx)
+	for _, r := range p.runners {
+		if err := r.plugin.Stop(ctx); err != nil {
+			r.logger.Error("stop plugin error", "err", err)
+		}
+	}
+
+	if p.acks != nil {
+		if err := p.acks.close(); err != nil {
+			p.logger.Error("close ack db error", "err", err)
+		}
 	}
 }
@@ -0,0 +1,186 @@
+package plugins
+
+import (
+	"testing"
+	"time"
+
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/plugins/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// recordPlugin records the contexts of all callbacks
+type recordPlugin struct {
+	logger log.Logger
+	calls  []string
+	ctxs   []types.Context
+
+	results []types.TxResult
+	events  []types.Event
+}
+
+func (r *recordPlugin) record(call string, ctx types.Context) {
+	r.calls = append(r.calls, call)
+	r.ctxs = append(r.ctxs, ctx)
+}
+
+func (r *recordPlugin) Init(types.Context) error  { return nil }
+func (r *recordPlugin) Start(types.Context) error { return nil }
+func (r *recordPlugin) Stop(types.Context) error  { return nil }
+
+func (r *recordPlugin) EvtHandler() types.PluginEvtHandler {
+	return func(ctx types.Context, evt types.Event) {
+		r.record("event", ctx)
+		r.events = append(r.events, evt)
+	}
+}
+
+func (r *recordPlugin) MsgHandler() types.PluginMsgHandler { return nil }
+
+func (r *recordPlugin) TxHandler() types.PluginTxHandler {
+	return func(ctx types.Context, tx chainTypes.StdTx) { r.record("tx", ctx) }
+}
+
+func (r *recordPlugin) TxResultHandler() types.PluginTxResultHandler {
+	return func(ctx types.Context, result types.TxResult) {
+		r.record("result", ctx)
+		r.results = append(r.results, result)
+	}
+}
+
+func (r *recordPlugin) BeginBlockHandler() types.PluginBeginBlockHandler {
+	return func(ctx types.Context, header abci.Header) { r.record("begin", ctx) }
+}
+
+func (r *recordPlugin) EndBlockHandler() types.PluginEndBlockHandler {
+	return func(ctx types.Context, req abci.RequestEndBlock) { r.record("end", ctx) }
+}
+
+func (r *recordPlugin) CommitHandler() types.PluginCommitHandler {
+	return func(ctx types.Context, appHash []byte) { r.record("commit", ctx) }
+}
+
+func (r *recordPlugin) Logger() log.Logger { return r.logger }
+func (r *recordPlugin) Name() string       { return "record" }
+
+func TestPluginBlockContext(t *testing.T) {
+	Convey("TestPluginBlockContext", t, func() {
+		logger := log.NewNopLogger()
+		plugin := &recordPlugin{logger: logger}
+
+		plugins = NewPlugins(logger)
+		defer func() { plugins = nil }()
+
+		So(plugins.RegPlugin(NewContext(logger), plugin, QueueCfg{}), ShouldBeNil)
+		So(plugins.Start(), ShouldBeNil)
+
+		blockTime := time.Now().UTC()
+		header := abci.Header{Height: 10, Time: blockTime, ProposerAddress: []byte("proposer")}
+		ctx := sdk.NewContext(nil, header, false, logger)
+		txDecoder := func(txBytes []byte) (sdk.Tx, error) {
+			return chainTypes.StdTx{Memo: string(txBytes)}, nil
+		}
+
+		HandleBeginBlock(ctx, abci.RequestBeginBlock{Header: header})
+
+		// tx0 succeeded with two msgs
+		HandleEvent(ctx, sdk.Events{sdk.NewEvent("transfer")})
+		HandleEvent(ctx, sdk.Events{sdk.NewEvent("transfer")})
+		HandleDeliverTx(txDecoder, abci.RequestDeliverTx{Tx: []byte("tx0")}, abci.ResponseDeliverTx{GasUsed: 100})
+
+		// tx1 failed in its second msg
+		HandleEvent(ctx, sdk.Events{sdk.NewEvent("transfer")})
+		HandleDeliverTx(txDecoder, abci.RequestDeliverTx{Tx: []byte("tx1")}, abci.ResponseDeliverTx{Code: 5, Log: "failed"})
+
+		// events of simulations are ignored
+		HandleEvent(ctx.WithIsCheckTx(true), sdk.Events{sdk.NewEvent("transfer")})
+
+		HandleEndBlock(ctx, abci.RequestEndBlock{Height: 10})
+		HandleCommit(10, []byte("apphash"))
+
+		plugins.Stop(NewContext(logger))
+
+		So(plugin.calls, ShouldResemble, []string{"begin", "tx", "event", "event", "result", "result", "end", "commit"})
+		for _, c := range plugin.ctxs {
+			So(c.BlockHeight(), ShouldEqual, 10)
+			So(c.BlockTime(), ShouldResemble, blockTime)
+			So(c.Proposer(), ShouldResemble, sdk.ConsAddress("proposer"))
+		}
+
+		So(plugin.ctxs[0].TxIndex(), ShouldEqual, -1)
+		So(plugin.ctxs[1].TxIndex(), ShouldEqual, 0)
+		So(plugin.ctxs[2].MsgIndex(), ShouldEqual, 0)
+		So(plugin.ctxs[3].MsgIndex(), ShouldEqual, 1)
+		So(plugin.ctxs[5].TxIndex(), ShouldEqual, 1)
+		So(plugin.ctxs[5].TxHash(), ShouldNotResemble, plugin.ctxs[4].TxHash())
+
+		So(plugin.results, ShouldHaveLength, 2)
+		So(plugin.results[0].IsOK(), ShouldBeTrue)
+		So(plugin.results[0].GasUsed, ShouldEqual, 100)
+		So(plugin.results[0].Tx.Memo, ShouldEqual, "tx0")
+		So(plugin.results[0].MsgEvents, ShouldHaveLength, 2)
+		So(plugin.results[1].IsOK(), ShouldBeFalse)
+		So(plugin.results[1].Log, ShouldEqual, "failed")
+		So(plugin.results[1].MsgEvents, ShouldBeEmpty)
+	})
+}
+
+func TestPluginBlockEvents(t *testing.T) {
+	Convey("TestPluginBlockEvents", t, func() {
+		logger := log.NewNopLogger()
+		plugin := &recordPlugin{logger: logger}
+
+		plugins = NewPlugins(logger)
+		defer func() { plugins = nil }()
+
+		So(plugins.RegPlugin(NewContext(logger), plugin, QueueCfg{}), ShouldBeNil)
+		So(plugins.Start(), ShouldBeNil)
+
+		txDecoder := func(txBytes []byte) (sdk.Tx, error) {
+			return chainTypes.StdTx{Memo: string(txBytes)}, nil
+		}
+
+		// events of the genesis and the gentxs delivered in it
+		genesisCtx := sdk.NewContext(nil, abci.Header{}, false, logger)
+		genesisCtx.EventManager().EmitEvent(sdk.NewEvent("genesis"))
+		HandleDeliverTx(txDecoder, abci.RequestDeliverTx{Tx: []byte("gentx")},
+			abci.ResponseDeliverTx{Events: []abci.Event{{Type: "gentx"}}})
+		HandleInitChain(genesisCtx)
+
+		header := abci.Header{Height: 1, Time: time.Now().UTC()}
+
+		beginCtx := sdk.NewContext(nil, header, false, logger)
+		beginCtx.EventManager().EmitEvent(sdk.NewEvent("mint"))
+		HandleBeginBlock(beginCtx, abci.RequestBeginBlock{Header: header})
+
+		// the events of the ante handler are kept for the tx failed
+		HandleDeliverTx(txDecoder, abci.RequestDeliverTx{Tx: []byte("tx0")},
+			abci.ResponseDeliverTx{Code: 5, Events: []abci.Event{{Type: "fee"}}})
+
+		endCtx := sdk.NewContext(nil, header, false, logger)
+		endCtx.EventManager().EmitEvent(sdk.NewEvent("unbond"))
+		HandleEndBlock(endCtx, abci.RequestEndBlock{Height: 1})
+		HandleCommit(1, []byte("apphash"))
+
+		plugins.Stop(NewContext(logger))
+
+		So(plugin.calls, ShouldResemble, []string{"begin", "event", "event", "event", "result", "event", "end", "commit"})
+		So(plugin.events, ShouldHaveLength, 4)
+		for i, typ := range []string{"gentx", "genesis", "mint", "unbond"} {
+			So(plugin.events[i].Type, ShouldEqual, typ)
+		}
+
+		for _, idx := range []int{1, 2, 3, 5} {
+			So(plugin.ctxs[idx].BlockHeight(), ShouldEqual, 1)
+			So(plugin.ctxs[idx].TxIndex(), ShouldEqual, -1)
+		}
+
+		So(plugin.results, ShouldHaveLength, 1)
+		So(plugin.results[0].Tx.Memo, ShouldEqual, "tx0")
+		So(plugin.ctxs[4].TxIndex(), ShouldEqual, 0)
+		So(plugin.results[0].Events, ShouldResemble, []types.Event{{Type: "fee", Attributes: map[string]string{}}})
+	})
+}
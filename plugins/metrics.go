@@ -0,0 +1,106 @@
+package plugins
+
+import (
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this package.
+	MetricsSubsystem = "plugins"
+
+	// metricsPluginLabel label for the plugin name, each metric is labeled by it
+	metricsPluginLabel = "plugin"
+)
+
+// Metrics contains metrics exposed by plugins.
+type Metrics struct {
+	// Number of msgs in the queue of the plugin, including the msgs spilled to disk.
+	QueueDepth metrics.Gauge
+	// Number of blocks emitted to the plugin but not handled yet.
+	Lag metrics.Gauge
+	// Number of msgs dropped as the queue of the plugin is full.
+	DroppedMsgs metrics.Counter
+	// Number of msgs spilled to disk as the queue of the plugin is full.
+	SpilledMsgs metrics.Counter
+	// Number of panics recovered from the plugin.
+	Panics metrics.Counter
+	// 1 if the plugin is disabled by a panic.
+	Disabled metrics.Gauge
+}
+
+// PrometheusMetrics returns Metrics build using Prometheus client library.
+// Optionally, labels can be provided along with their values ("foo",
+// "fooValue").
+func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
+	labels := []string{}
+	for i := 0; i < len(labelsAndValues); i += 2 {
+		labels = append(labels, labelsAndValues[i])
+	}
+	labels = append(labels, metricsPluginLabel)
+
+	return &Metrics{
+		QueueDepth: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "queue_depth",
+			Help:      "Number of msgs in the queue of the plugin.",
+		}, labels).With(labelsAndValues...),
+		Lag: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "lag",
+			Help:      "Number of blocks emitted to the plugin but not handled yet.",
+		}, labels).With(labelsAndValues...),
+		DroppedMsgs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "dropped_msgs",
+			Help:      "Number of msgs dropped as the queue of the plugin is full.",
+		}, labels).With(labelsAndValues...),
+		SpilledMsgs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "spilled_msgs",
+			Help:      "Number of msgs spilled to disk as the queue of the plugin is full.",
+		}, labels).With(labelsAndValues...),
+		Panics: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "panics",
+			Help:      "Number of panics recovered from the plugin.",
+		}, labels).With(labelsAndValues...),
+		Disabled: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "disabled",
+			Help:      "Whether the plugin is disabled by a panic.",
+		}, labels).With(labelsAndValues...),
+	}
+}
+
+// NopMetrics returns no-op Metrics.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		QueueDepth:  discard.NewGauge(),
+		Lag:         discard.NewGauge(),
+		DroppedMsgs: discard.NewCounter(),
+		SpilledMsgs: discard.NewCounter(),
+		Panics:      discard.NewCounter(),
+		Disabled:    discard.NewGauge(),
+	}
+}
+
+// forPlugin returns the metrics labeled by the plugin name
+func (m *Metrics) forPlugin(name string) *Metrics {
+	return &Metrics{
+		QueueDepth:  m.QueueDepth.With(metricsPluginLabel, name),
+		Lag:         m.Lag.With(metricsPluginLabel, name),
+		DroppedMsgs: m.DroppedMsgs.With(metricsPluginLabel, name),
+		SpilledMsgs: m.SpilledMsgs.With(metricsPluginLabel, name),
+		Panics:      m.Panics.With(metricsPluginLabel, name),
+		Disabled:    m.Disabled.With(metricsPluginLabel, name),
+	}
+}
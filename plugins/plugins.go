@@ -1,10 +1,7 @@
 package plugins
 
 import (
-	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
-	dbHistory "github.com/KuChainNetwork/kuchain/plugins/db_history"
-	"github.com/KuChainNetwork/kuchain/plugins/test"
-	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
 )
 
 // TODO: use a goroutine
@@ -12,14 +9,24 @@ var (
 	plugins *Plugins
 )
 
-func InitPlugins(ctx Context, cfgs []BaseCfg) error {
-	plugins = NewPlugins(ctx.Logger().With("module", "plugins"))
+// InitPlugins creates and starts the plugins in cfgs
+func InitPlugins(ctx Context, cfgs []BaseCfg, options ...Option) error {
+	if err := ValidateCfgs(cfgs); err != nil {
+		return errors.Wrap(err, "invalid plugin config")
+	}
+
+	ps := NewPlugins(ctx.Logger().With("module", "plugins"), options...)
 	for _, cfg := range cfgs {
-		initPlugin(ctx, cfg, plugins)
+		if err := initPlugin(ctx, cfg, ps); err != nil {
+			return err
+		}
 	}
 
-	plugins.Start()
+	if err := ps.Start(); err != nil {
+		return errors.Wrap(err, "start plugins")
+	}
 
+	plugins = ps
 	return nil
 }
 
@@ -29,31 +36,13 @@ func StopPlugins(ctx Context) {
 	}
 }
 
-func initPlugin(ctx Context, cfg BaseCfg, plugins *Plugins) {
-	switch cfg.Name {
-	case test.PluginName:
-		plugins.RegPlugin(ctx, test.NewTestPlugin(ctx, cfg))
-	case dbHistory.PluginName:
-		plugins.RegPlugin(ctx, dbHistory.New(ctx, cfg))
-	}
-}
-
-// HandleEvent plugins handler Events
-func HandleEvent(ctx sdk.Context, evts sdk.Events) {
-	if plugins == nil {
-		return
-	}
-
-	for _, evt := range evts {
-		plugins.EmitEvent(evt)
-	}
-}
+func initPlugin(ctx Context, cfg BaseCfg, plugins *Plugins) error {
+	factory, _ := getFactory(cfg.Name)
 
-// HandleTx handler tx for each plugins
-func HandleTx(ctxSdk sdk.Context, tx chainTypes.StdTx) {
-	if plugins == nil {
-		return
+	plugin, err := factory(ctx, cfg)
+	if err != nil {
+		return errors.Wrapf(err, "create plugin %s", cfg.Name)
 	}
 
-	plugins.EmitTx(tx)
+	return errors.Wrapf(plugins.RegPlugin(ctx, plugin, cfg.Queue), "reg plugin %s", cfg.Name)
 }
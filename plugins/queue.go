@@ -0,0 +1,241 @@
+package plugins
+
+import (
+	"sync"
+
+	"github.com/KuChainNetwork/kuchain/plugins/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// msgQueue the queue of msgs to a plugin, msgs are pushed by the consensus goroutine
+// and popped by the goroutine of the plugin, when the queue is full it works as the
+// overflow policy: block the pusher, drop the msg or spill the msg to disk. Commit msgs
+// are never dropped, the heights of blocks with msgs dropped are recorded as gaps.
+type msgQueue struct {
+	policy  string
+	metrics *Metrics
+	logger  log.Logger
+
+	ch     chan pluginMsg
+	notify chan struct{}
+
+	// spill is only used by spill policy, msgs are spilled once the channel is full,
+	// and then all msgs are spilled until all spilled msgs are popped to keep the order
+	spillMtx sync.Mutex
+	spill    *spillStore
+
+	// dropped the heights of blocks with msgs dropped, taken by the runner at the commit of blocks
+	droppedMtx sync.Mutex
+	dropped    map[int64]struct{}
+
+	closeMtx sync.RWMutex
+	closed   bool
+}
+
+func newMsgQueue(cfg types.QueueCfg, spill *spillStore, metrics *Metrics, logger log.Logger) *msgQueue {
+	return &msgQueue{
+		policy:  cfg.Overflow,
+		metrics: metrics,
+		logger:  logger,
+		ch:      make(chan pluginMsg, cfg.Size),
+		notify:  make(chan struct{}, 1),
+		spill:   spill,
+		dropped: make(map[int64]struct{}),
+	}
+}
+
+// push pushes a msg to the queue
+func (q *msgQueue) push(msg pluginMsg) {
+	q.closeMtx.RLock()
+	defer q.closeMtx.RUnlock()
+
+	if q.closed {
+		return
+	}
+
+	switch q.policy {
+	case types.OverflowDrop:
+		if _, ok := msg.(*types.MsgCommit); ok {
+			q.ch <- msg
+			break
+		}
+
+		select {
+		case q.ch <- msg:
+		default:
+			q.drop(msg)
+		}
+	case types.OverflowSpill:
+		q.pushOrSpill(msg)
+	default:
+		q.ch <- msg
+	}
+
+	q.updateDepth()
+}
+
+func (q *msgQueue) pushOrSpill(msg pluginMsg) {
+	q.spillMtx.Lock()
+	defer q.spillMtx.Unlock()
+
+	if q.spill.len() == 0 {
+		select {
+		case q.ch <- msg:
+			return
+		default:
+		}
+	}
+
+	if err := q.spill.push(msg); err != nil {
+		q.logger.Error("spill msg error, drop it", "err", err)
+		q.drop(msg)
+		return
+	}
+	q.metrics.SpilledMsgs.Add(1)
+
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+}
+
+// pop pops a msg from the queue, it blocks until there is a msg,
+// returns false if the queue is closed and all msgs are popped
+func (q *msgQueue) pop() (pluginMsg, bool) {
+	defer q.updateDepth()
+
+	for {
+		// msgs in channel are pushed before the spilled msgs
+		select {
+		case msg, ok := <-q.ch:
+			if !ok {
+				return q.popSpilled()
+			}
+			return msg, true
+		default:
+		}
+
+		if msg, ok := q.popSpilled(); ok {
+			return msg, true
+		}
+
+		select {
+		case msg, ok := <-q.ch:
+			if !ok {
+				return q.popSpilled()
+			}
+			return msg, true
+		case <-q.notify:
+		}
+	}
+}
+
+func (q *msgQueue) popSpilled() (pluginMsg, bool) {
+	if q.spill == nil {
+		return nil, false
+	}
+
+	q.spillMtx.Lock()
+	defer q.spillMtx.Unlock()
+
+	for q.spill.len() > 0 {
+		msg, err := q.spill.pop()
+		if err != nil {
+			// the height of the msg is unknown, so the gap starts from the last msg popped
+			q.logger.Error("pop spilled msg error, drop it", "err", err)
+			q.drop(nil)
+			continue
+		}
+
+		return msg, true
+	}
+
+	return nil, false
+}
+
+// drop drops the msg and records the height of its block as a gap, the height is 0 if unknown,
+// which makes the next block taken as dropped
+func (q *msgQueue) drop(msg pluginMsg) {
+	height := msgHeight(msg)
+
+	q.logger.Error("msg dropped, there is a gap in the blocks handled by plugin", "height", height)
+	q.metrics.DroppedMsgs.Add(1)
+
+	q.droppedMtx.Lock()
+	q.dropped[height] = struct{}{}
+	q.droppedMtx.Unlock()
+}
+
+// takeDropped returns if any msg of the blocks up to height were dropped, and clears them
+func (q *msgQueue) takeDropped(height int64) bool {
+	q.droppedMtx.Lock()
+	defer q.droppedMtx.Unlock()
+
+	res := false
+	for h := range q.dropped {
+		if h <= height {
+			delete(q.dropped, h)
+			res = true
+		}
+	}
+
+	return res
+}
+
+// msgHeight returns the height of the block of msg, 0 if unknown
+func msgHeight(msg pluginMsg) int64 {
+	switch msg := msg.(type) {
+	case *types.MsgTxResult:
+		return msg.Ctx.BlockHeight()
+	case *types.MsgBeginBlock:
+		return msg.Ctx.BlockHeight()
+	case *types.MsgBlockEvents:
+		return msg.Ctx.BlockHeight()
+	case *types.MsgEndBlock:
+		return msg.Ctx.BlockHeight()
+	case *types.MsgCommit:
+		return msg.Ctx.BlockHeight()
+	}
+
+	return 0
+}
+
+func (q *msgQueue) len() int {
+	res := len(q.ch)
+
+	if q.spill != nil {
+		q.spillMtx.Lock()
+		res += q.spill.len()
+		q.spillMtx.Unlock()
+	}
+
+	return res
+}
+
+func (q *msgQueue) updateDepth() {
+	q.metrics.QueueDepth.Set(float64(q.len()))
+}
+
+// close closes the queue, the msgs in queue can still be popped
+func (q *msgQueue) close() {
+	q.closeMtx.Lock()
+	defer q.closeMtx.Unlock()
+
+	if q.closed {
+		return
+	}
+
+	q.closed = true
+	close(q.ch)
+}
+
+// release releases the spill store, should be called after all msgs are popped
+func (q *msgQueue) release() {
+	if q.spill == nil {
+		return
+	}
+
+	if err := q.spill.close(); err != nil {
+		q.logger.Error("close spill store error", "err", err)
+	}
+}
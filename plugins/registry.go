@@ -0,0 +1,82 @@
+package plugins
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Factory creates a plugin from its config, each plugin package register its factory by Register
+type Factory func(ctx Context, cfg BaseCfg) (Plugin, error)
+
+var (
+	factoriesMutex sync.RWMutex
+	factories      = make(map[string]Factory)
+)
+
+// Register register a plugin factory by name, it should be called in the `init()` of the plugin package,
+// it will panic if the name is empty or registered twice.
+func Register(name string, factory Factory) {
+	factoriesMutex.Lock()
+	defer factoriesMutex.Unlock()
+
+	if name == "" {
+		panic("plugins: register plugin with empty name")
+	}
+
+	if factory == nil {
+		panic(fmt.Sprintf("plugins: register plugin %s with nil factory", name))
+	}
+
+	if _, ok := factories[name]; ok {
+		panic(fmt.Sprintf("plugins: plugin %s registered twice", name))
+	}
+
+	factories[name] = factory
+}
+
+// RegisteredPlugins returns the names of all registered plugins, sorted
+func RegisteredPlugins() []string {
+	factoriesMutex.RLock()
+	defer factoriesMutex.RUnlock()
+
+	res := make([]string, 0, len(factories))
+	for name := range factories {
+		res = append(res, name)
+	}
+	sort.Strings(res)
+
+	return res
+}
+
+func getFactory(name string) (Factory, bool) {
+	factoriesMutex.RLock()
+	defer factoriesMutex.RUnlock()
+
+	f, ok := factories[name]
+	return f, ok
+}
+
+// ValidateCfgs check each plugin config map to a registered plugin with a valid queue config,
+// and no plugin is enabled twice
+func ValidateCfgs(cfgs []BaseCfg) error {
+	enabled := make(map[string]bool, len(cfgs))
+	for _, cfg := range cfgs {
+		if _, ok := getFactory(cfg.Name); !ok {
+			return fmt.Errorf("plugin %q in config is not registered, available plugins: [%s]",
+				cfg.Name, strings.Join(RegisteredPlugins(), ", "))
+		}
+
+		if err := cfg.Queue.Validate(); err != nil {
+			return fmt.Errorf("plugin %q queue config: %s", cfg.Name, err.Error())
+		}
+
+		if enabled[cfg.Name] {
+			return fmt.Errorf("plugin %q is enabled more than once in config", cfg.Name)
+		}
+		enabled[cfg.Name] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package plugins
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func TestPluginRegistry(t *testing.T) {
+	Convey("TestPluginRegistry", t, func() {
+		logger := log.NewNopLogger()
+		plugin := &recordPlugin{logger: logger}
+
+		Register("record", func(ctx Context, cfg BaseCfg) (Plugin, error) {
+			return plugin, nil
+		})
+		defer func() {
+			delete(factories, "record")
+			StopPlugins(NewContext(logger))
+			plugins = nil
+		}()
+
+		So(RegisteredPlugins(), ShouldContain, "record")
+		So(func() {
+			Register("record", func(ctx Context, cfg BaseCfg) (Plugin, error) { return nil, nil })
+		}, ShouldPanic)
+
+		So(ValidateCfgs([]BaseCfg{{Name: "record"}}), ShouldBeNil)
+		So(ValidateCfgs([]BaseCfg{{Name: "record"}, {Name: "record"}}), ShouldNotBeNil)
+
+		err := InitPlugins(NewContext(logger), []BaseCfg{{Name: "unknown"}})
+		So(err, ShouldNotBeNil)
+		So(err.Error(), ShouldContainSubstring, `plugin "unknown" in config is not registered`)
+		So(plugins, ShouldBeNil)
+
+		So(InitPlugins(NewContext(logger), []BaseCfg{{Name: "record"}}), ShouldBeNil)
+		So(plugins.runners, ShouldHaveLength, 1)
+	})
+}
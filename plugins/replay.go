@@ -0,0 +1,164 @@
+package plugins
+
+import (
+	"fmt"
+
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/plugins/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmsm "github.com/tendermint/tendermint/state"
+	tmstore "github.com/tendermint/tendermint/store"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// BlockSource the source of blocks committed, which are the block store and the abci responses
+// saved in the tendermint state db
+type BlockSource struct {
+	BlockStore *tmstore.BlockStore
+	StateDB    dbm.DB
+	TxDecoder  sdk.TxDecoder
+}
+
+// Enabled returns if there are plugins running
+func Enabled() bool {
+	return plugins != nil
+}
+
+// ReplayBlocks replays the blocks after the height acknowledged by each plugin up to height.
+// It should be called before the node starts, so that the replayed blocks are handled before
+// the new blocks.
+func ReplayBlocks(src BlockSource, height int64) error {
+	if plugins == nil {
+		return nil
+	}
+
+	return plugins.replayBlocks(src, height)
+}
+
+func (p *Plugins) replayBlocks(src BlockSource, height int64) error {
+	// plugins acked nothing are new, they handle blocks from now on
+	from := height + 1
+	for _, r := range p.runners {
+		if r.acked > 0 && r.acked < from {
+			from = r.acked + 1
+		}
+	}
+
+	if from > height {
+		return nil
+	}
+
+	p.logger.Info("replay blocks to plugins", "from", from, "to", height)
+
+	for h := from; h <= height; h++ {
+		runners := make([]*pluginRunner, 0, len(p.runners))
+		for _, r := range p.runners {
+			if r.acked > 0 && r.acked < h {
+				runners = append(runners, r)
+			}
+		}
+
+		if err := p.replayBlock(src, h, runners); err != nil {
+			return fmt.Errorf("replay block %d to plugins: %s", h, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// replayBlock replays the block at height to runners
+func (p *Plugins) replayBlock(src BlockSource, height int64, runners []*pluginRunner) error {
+	block := src.BlockStore.LoadBlock(height)
+	if block == nil {
+		return fmt.Errorf("block not found in block store")
+	}
+
+	responses, err := tmsm.LoadABCIResponses(src.StateDB, height)
+	if err != nil {
+		return err
+	}
+
+	if len(responses.DeliverTxs) != len(block.Txs) {
+		return fmt.Errorf("abci responses mismatch txs in block, %d != %d", len(responses.DeliverTxs), len(block.Txs))
+	}
+
+	header := tmtypes.TM2PB.Header(&block.Header)
+	ctx := types.NewContext(p.logger).
+		WithChainID(header.ChainID).
+		WithBlockHeight(header.Height).
+		WithBlockTime(header.Time).
+		WithProposer(sdk.ConsAddress(header.ProposerAddress))
+
+	emit := func(ctx types.Context, msg pluginMsg) {
+		for _, r := range runners {
+			r.push(ctx, msg)
+		}
+	}
+
+	emit(ctx, types.NewMsgBeginBlock(ctx, header))
+
+	// the events of the genesis are not saved by tendermint, so they are not replayed with the first block
+	if responses.BeginBlock != nil && len(responses.BeginBlock.Events) > 0 {
+		emit(ctx, types.NewMsgBlockEvents(ctx, types.FromABCIEvents(responses.BeginBlock.Events)))
+	}
+
+	for idx, txBytes := range block.Txs {
+		res := responses.DeliverTxs[idx]
+
+		var stdTx chainTypes.StdTx
+		if tx, err := src.TxDecoder(txBytes); err == nil {
+			stdTx, _ = tx.(chainTypes.StdTx)
+		}
+
+		txCtx := ctx.WithTx(txBytes.Hash(), idx)
+		emit(txCtx, types.NewMsgTxResult(txCtx, types.NewTxResult(stdTx, *res, msgEventsFromDeliverTx(*res))))
+	}
+
+	if responses.EndBlock != nil && len(responses.EndBlock.Events) > 0 {
+		emit(ctx, types.NewMsgBlockEvents(ctx, types.FromABCIEvents(responses.EndBlock.Events)))
+	}
+
+	emit(ctx, types.NewMsgEndBlock(ctx, abci.RequestEndBlock{Height: height}))
+
+	// the app hash after the block is in the header of the next block
+	var appHash []byte
+	if meta := src.BlockStore.LoadBlockMeta(height + 1); meta != nil {
+		appHash = meta.Header.AppHash
+	} else {
+		appHash = tmsm.LoadState(src.StateDB).AppHash
+	}
+
+	emit(ctx, types.NewMsgCommit(ctx, appHash))
+
+	return nil
+}
+
+// msgEventsFromDeliverTx splits the events of a tx to the events of each msg, events of each msg
+// are led by a message event with only the action of the msg, which is added by baseapp.
+func msgEventsFromDeliverTx(res abci.ResponseDeliverTx) []sdk.Events {
+	var msgEvents []sdk.Events
+
+	for _, evt := range res.Events {
+		if isMsgActionEvent(evt) {
+			msgEvents = append(msgEvents, sdk.Events{})
+			continue
+		}
+
+		if len(msgEvents) == 0 {
+			continue
+		}
+
+		last := len(msgEvents) - 1
+		msgEvents[last] = append(msgEvents[last], sdk.Event(evt))
+	}
+
+	return msgEvents
+}
+
+func isMsgActionEvent(evt abci.Event) bool {
+	return evt.Type == sdk.EventTypeMessage &&
+		len(evt.Attributes) == 1 &&
+		string(evt.Attributes[0].Key) == sdk.AttributeKeyAction
+}
@@ -0,0 +1,299 @@
+package plugins
+
+import (
+	"fmt"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/KuChainNetwork/kuchain/plugins/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+const waitHandledInterval = 10 * time.Millisecond
+
+// pluginRunner runs a plugin in its own goroutine, msgs to the plugin are passed by its queue,
+// so a slow plugin will not block others, and a panic in the plugin only disables itself.
+type pluginRunner struct {
+	cdc     *codec.Codec
+	plugin  Plugin
+	queue   *msgQueue
+	acks    *ackStore
+	metrics *Metrics
+	logger  log.Logger
+
+	txHandler         types.PluginTxHandler
+	msgHandler        types.PluginMsgHandler
+	evtHandler        types.PluginEvtHandler
+	txResultHandler   types.PluginTxResultHandler
+	beginBlockHandler types.PluginBeginBlockHandler
+	endBlockHandler   types.PluginEndBlockHandler
+	commitHandler     types.PluginCommitHandler
+
+	// acked is the height of the last block acknowledged by the plugin when started,
+	// blocks replayed to the plugin are after it
+	acked int64
+
+	// emitted and handled are the heights of the last block emitted to and handled by the plugin
+	emitted  int64
+	handled  int64
+	disabled int32
+
+	// lastAcked is the height of the last block acked, it only advances block by block
+	lastAcked int64
+
+	// gap is set once msgs of a block are dropped or a block is missed, the blocks after
+	// are not acked, so they are replayed to the plugin after restart
+	gap bool
+
+	wg sync.WaitGroup
+}
+
+func newPluginRunner(cdc *codec.Codec, plugin Plugin, queue *msgQueue, acks *ackStore, metrics *Metrics, logger log.Logger) *pluginRunner {
+	return &pluginRunner{
+		cdc:     cdc,
+		plugin:  plugin,
+		queue:   queue,
+		acks:    acks,
+		metrics: metrics,
+		logger:  logger,
+
+		txHandler:         plugin.TxHandler(),
+		msgHandler:        plugin.MsgHandler(),
+		evtHandler:        plugin.EvtHandler(),
+		txResultHandler:   plugin.TxResultHandler(),
+		beginBlockHandler: plugin.BeginBlockHandler(),
+		endBlockHandler:   plugin.EndBlockHandler(),
+		commitHandler:     plugin.CommitHandler(),
+	}
+}
+
+// IsDisabled returns if the plugin is disabled by a panic
+func (r *pluginRunner) IsDisabled() bool {
+	return atomic.LoadInt32(&r.disabled) != 0
+}
+
+func (r *pluginRunner) disable(reason interface{}) {
+	r.logger.Error("plugin panic, disable it",
+		"name", r.plugin.Name(), "err", fmt.Sprintf("%v", reason), "stack", string(debug.Stack()))
+
+	atomic.StoreInt32(&r.disabled, 1)
+	r.metrics.Panics.Add(1)
+	r.metrics.Disabled.Set(1)
+}
+
+// loadAckedHeight loads the height of the last block acknowledged by the plugin
+func (r *pluginRunner) loadAckedHeight(ctx types.Context) error {
+	var err error
+
+	if acker, ok := r.plugin.(types.PluginAcker); ok {
+		r.acked, err = acker.LastAckedHeight(ctx)
+	} else if r.acks != nil {
+		r.acked, err = r.acks.get(r.plugin.Name())
+	}
+
+	if err != nil {
+		return err
+	}
+
+	atomic.StoreInt64(&r.handled, r.acked)
+	atomic.StoreInt64(&r.lastAcked, r.acked)
+	r.logger.Info("plugin acked height", "name", r.plugin.Name(), "height", r.acked)
+
+	return nil
+}
+
+// resetAcked resets the height acknowledged by the plugin, blocks after it will be handled
+func (r *pluginRunner) resetAcked(height int64) {
+	r.acked = height
+	atomic.StoreInt64(&r.handled, height)
+	atomic.StoreInt64(&r.lastAcked, height)
+	r.updateLag()
+}
+
+// waitHandled waits until the plugin handled the block at height
+func (r *pluginRunner) waitHandled(height int64) error {
+	for atomic.LoadInt64(&r.handled) < height {
+		if r.IsDisabled() {
+			return fmt.Errorf("plugin %s is disabled", r.plugin.Name())
+		}
+
+		time.Sleep(waitHandledInterval)
+	}
+
+	return nil
+}
+
+// ack persists the height of the block handled by the plugin, the height is only acked if it
+// follows the last acked one and no msg of the block was dropped
+func (r *pluginRunner) ack(height int64) {
+	atomic.StoreInt64(&r.handled, height)
+	r.updateLag()
+
+	if r.queue.takeDropped(height) && !r.gap {
+		r.logger.Error("msgs of block dropped, stop acking blocks until restart", "name", r.plugin.Name(), "height", height)
+		r.gap = true
+	}
+
+	// the plugin acked nothing is new, it acks from the first block handled
+	lastAcked := atomic.LoadInt64(&r.lastAcked)
+	if lastAcked > 0 && height > lastAcked+1 && !r.gap {
+		r.logger.Error("blocks missed, stop acking blocks until restart", "name", r.plugin.Name(),
+			"acked", lastAcked, "height", height)
+		r.gap = true
+	}
+
+	if r.gap || (lastAcked > 0 && height != lastAcked+1) {
+		return
+	}
+
+	atomic.StoreInt64(&r.lastAcked, height)
+
+	if _, ok := r.plugin.(types.PluginAcker); ok || r.acks == nil {
+		return
+	}
+
+	if err := r.acks.set(r.plugin.Name(), height); err != nil {
+		r.logger.Error("persist acked height error", "name", r.plugin.Name(), "height", height, "err", err)
+	}
+}
+
+func (r *pluginRunner) start() {
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		defer r.queue.release()
+
+		for {
+			msg, ok := r.queue.pop()
+			if !ok {
+				r.logger.Info("msg queue closed", "name", r.plugin.Name())
+				return
+			}
+
+			// msgs to a disabled plugin are dropped, the queue is still drained to not block the pusher
+			if r.IsDisabled() {
+				continue
+			}
+
+			r.handle(msg)
+		}
+	}()
+}
+
+func (r *pluginRunner) push(ctx types.Context, msg pluginMsg) {
+	if r.IsDisabled() {
+		return
+	}
+
+	atomic.StoreInt64(&r.emitted, ctx.BlockHeight())
+	r.updateLag()
+
+	r.queue.push(msg)
+}
+
+func (r *pluginRunner) stop() {
+	r.queue.close()
+	r.wg.Wait()
+}
+
+func (r *pluginRunner) updateLag() {
+	r.metrics.Lag.Set(float64(atomic.LoadInt64(&r.emitted) - atomic.LoadInt64(&r.handled)))
+}
+
+func (r *pluginRunner) handle(msg pluginMsg) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			r.disable(rec)
+		}
+	}()
+
+	var ctx types.Context
+
+	switch msg := msg.(type) {
+	case *types.MsgTxResult:
+		ctx = r.context(msg.Ctx)
+		r.onTxResult(ctx, msg.Result)
+	case *types.MsgBeginBlock:
+		ctx = r.context(msg.Ctx)
+		r.onBeginBlock(ctx, msg.Header)
+	case *types.MsgBlockEvents:
+		ctx = r.context(msg.Ctx)
+		r.onBlockEvents(ctx, msg.Events)
+	case *types.MsgEndBlock:
+		ctx = r.context(msg.Ctx)
+		r.onEndBlock(ctx, msg.Req)
+	case *types.MsgCommit:
+		ctx = r.context(msg.Ctx)
+		r.onCommit(ctx, msg.AppHash)
+		r.ack(ctx.BlockHeight())
+	}
+}
+
+// context returns the context for the callbacks of the plugin
+func (r *pluginRunner) context(ctx types.Context) types.Context {
+	return ctx.WithLogger(r.logger).WithCodec(r.cdc)
+}
+
+// onTxResult handles a delivered tx, only txs succeeded are passed to the tx, msg
+// and event handlers, while all results are passed to the tx result handler.
+func (r *pluginRunner) onTxResult(ctx types.Context, result types.TxResult) {
+	if result.IsOK() {
+		r.onTx(ctx, result.Tx)
+
+		if r.evtHandler != nil {
+			for idx, evts := range result.MsgEvents {
+				for _, evt := range evts {
+					r.evtHandler(ctx.WithMsgIndex(idx), evt)
+				}
+			}
+		}
+	}
+
+	if r.txResultHandler != nil {
+		r.txResultHandler(ctx, result)
+	}
+}
+
+func (r *pluginRunner) onTx(ctx types.Context, tx StdTx) {
+	if r.txHandler != nil {
+		r.txHandler(ctx, tx)
+	}
+
+	if r.msgHandler != nil {
+		for idx, msg := range tx.Msgs {
+			r.msgHandler(ctx.WithMsgIndex(idx), msg)
+		}
+	}
+}
+
+func (r *pluginRunner) onBeginBlock(ctx types.Context, header abci.Header) {
+	if r.beginBlockHandler != nil {
+		r.beginBlockHandler(ctx, header)
+	}
+}
+
+// onBlockEvents handles the events out of txs, which are passed to the event handler
+// with the context not in a tx
+func (r *pluginRunner) onBlockEvents(ctx types.Context, events []types.Event) {
+	if r.evtHandler != nil {
+		for _, evt := range events {
+			r.evtHandler(ctx, evt)
+		}
+	}
+}
+
+func (r *pluginRunner) onEndBlock(ctx types.Context, req abci.RequestEndBlock) {
+	if r.endBlockHandler != nil {
+		r.endBlockHandler(ctx, req)
+	}
+}
+
+func (r *pluginRunner) onCommit(ctx types.Context, appHash []byte) {
+	if r.commitHandler != nil {
+		r.commitHandler(ctx, appHash)
+	}
+}
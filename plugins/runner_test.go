@@ -0,0 +1,223 @@
+package plugins
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/plugins/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// blockPlugin records the heights of blocks begun, calls onBegin before record
+type blockPlugin struct {
+	recordPlugin
+	name    string
+	onBegin func(height int64)
+	heights []int64
+}
+
+func (b *blockPlugin) Name() string { return b.name }
+
+func (b *blockPlugin) BeginBlockHandler() types.PluginBeginBlockHandler {
+	return func(ctx types.Context, header abci.Header) {
+		if b.onBegin != nil {
+			b.onBegin(header.Height)
+		}
+		b.heights = append(b.heights, header.Height)
+	}
+}
+
+func newBlockPlugin(name string, onBegin func(int64)) *blockPlugin {
+	return &blockPlugin{
+		recordPlugin: recordPlugin{logger: log.NewNopLogger()},
+		name:         name,
+		onBegin:      onBegin,
+	}
+}
+
+func emitBlocks(p *Plugins, from, to int64) {
+	for h := from; h <= to; h++ {
+		ctx := NewContext(log.NewNopLogger()).WithBlockHeight(h)
+		p.EmitBeginBlock(ctx, abci.Header{Height: h})
+		p.EmitTxResult(ctx.WithTx([]byte("hash"), 0), types.TxResult{Tx: chainTypes.StdTx{Memo: "memo"}})
+		p.EmitCommit(ctx, []byte("apphash"))
+	}
+}
+
+func TestPluginPanicIsolation(t *testing.T) {
+	Convey("TestPluginPanicIsolation", t, func() {
+		logger := log.NewNopLogger()
+		ps := NewPlugins(logger)
+
+		faulty := newBlockPlugin("faulty", func(height int64) {
+			if height == 3 {
+				panic("faulty plugin")
+			}
+		})
+		normal := newBlockPlugin("normal", nil)
+
+		So(ps.RegPlugin(NewContext(logger), faulty, QueueCfg{}), ShouldBeNil)
+		So(ps.RegPlugin(NewContext(logger), normal, QueueCfg{}), ShouldBeNil)
+		So(ps.Start(), ShouldBeNil)
+
+		emitBlocks(ps, 1, 5)
+		ps.Stop(NewContext(logger))
+
+		So(faulty.heights, ShouldResemble, []int64{1, 2})
+		So(ps.runners[0].IsDisabled(), ShouldBeTrue)
+		So(normal.heights, ShouldResemble, []int64{1, 2, 3, 4, 5})
+		So(ps.runners[1].IsDisabled(), ShouldBeFalse)
+	})
+}
+
+func TestPluginQueueOverflow(t *testing.T) {
+	Convey("TestPluginQueueOverflow", t, func() {
+		logger := log.NewNopLogger()
+
+		dir, err := ioutil.TempDir("", "plugins")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+
+		cdc := codec.New()
+		sdk.RegisterCodec(cdc)
+		chainTypes.RegisterCodec(cdc)
+
+		db := dbm.NewMemDB()
+		ps := NewPlugins(logger, WithAckDB(db))
+
+		// the plugins are blocked in the first block until msgs overflow
+		release := make(chan struct{})
+		wait := func(height int64) {
+			if height == 1 {
+				<-release
+			}
+		}
+
+		dropped := newBlockPlugin("dropped", wait)
+		spilled := newBlockPlugin("spilled", wait)
+
+		So(ps.RegPlugin(NewContext(logger).WithCodec(cdc), dropped, QueueCfg{Size: 2, Overflow: types.OverflowDrop}), ShouldBeNil)
+		So(ps.RegPlugin(NewContext(logger).WithCodec(cdc), spilled, QueueCfg{Size: 2, Overflow: types.OverflowSpill, SpillDir: dir}), ShouldBeNil)
+		So(ps.Start(), ShouldBeNil)
+
+		// commit msgs are never dropped, so the emitting waits for the dropped plugin
+		emitted := make(chan struct{})
+		go func() {
+			emitBlocks(ps, 1, 10)
+			close(emitted)
+		}()
+
+		for ps.runners[1].queue.len() <= 2 {
+			time.Sleep(waitHandledInterval)
+		}
+
+		close(release)
+		<-emitted
+		ps.Stop(NewContext(logger))
+
+		So(len(dropped.heights), ShouldBeLessThan, 10)
+		So(dropped.heights[0], ShouldEqual, 1)
+		So(dropped.heights, ShouldNotContain, 2)
+
+		// the blocks from the first one with msgs dropped are not acked
+		acks := newAckStore(db)
+		acked, err := acks.get("dropped")
+		So(err, ShouldBeNil)
+		So(acked, ShouldEqual, 1)
+
+		acked, err = acks.get("spilled")
+		So(err, ShouldBeNil)
+		So(acked, ShouldEqual, 10)
+		So(spilled.heights, ShouldResemble, []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
+		So(spilled.calls, ShouldHaveLength, 30)
+		So(spilled.ctxs[len(spilled.ctxs)-1].BlockHeight(), ShouldEqual, 10)
+	})
+}
+
+// ackerPlugin acks blocks by itself
+type ackerPlugin struct {
+	*blockPlugin
+}
+
+func (a ackerPlugin) LastAckedHeight(ctx types.Context) (int64, error) { return 0, nil }
+
+func TestPluginDropByAcker(t *testing.T) {
+	Convey("TestPluginDropByAcker", t, func() {
+		logger := log.NewNopLogger()
+		ps := NewPlugins(logger)
+
+		acker := ackerPlugin{newBlockPlugin("acker", nil)}
+		So(ps.RegPlugin(NewContext(logger), acker, QueueCfg{Overflow: types.OverflowDrop}), ShouldNotBeNil)
+		So(ps.RegPlugin(NewContext(logger), acker, QueueCfg{Overflow: types.OverflowBlock}), ShouldBeNil)
+	})
+}
+
+func TestPluginAckedHeight(t *testing.T) {
+	Convey("TestPluginAckedHeight", t, func() {
+		logger := log.NewNopLogger()
+		db := dbm.NewMemDB()
+
+		ps := NewPlugins(logger, WithAckDB(db))
+		plugin := newBlockPlugin("acked", nil)
+		So(ps.RegPlugin(NewContext(logger), plugin, QueueCfg{}), ShouldBeNil)
+		So(ps.Start(), ShouldBeNil)
+		So(ps.runners[0].acked, ShouldEqual, 0)
+
+		emitBlocks(ps, 1, 3)
+		for _, r := range ps.runners {
+			r.stop()
+		}
+
+		acked, err := newAckStore(db).get("acked")
+		So(err, ShouldBeNil)
+		So(acked, ShouldEqual, 3)
+
+		ps = NewPlugins(logger, WithAckDB(db))
+		So(ps.RegPlugin(NewContext(logger), newBlockPlugin("acked", nil), QueueCfg{}), ShouldBeNil)
+		So(ps.Start(), ShouldBeNil)
+		So(ps.runners[0].acked, ShouldEqual, 3)
+
+		// block 4 is missed, the blocks after are not acked
+		emitBlocks(ps, 5, 6)
+		ps.Stop(NewContext(logger))
+
+		acked, err = newAckStore(db).get("acked")
+		So(err, ShouldBeNil)
+		So(acked, ShouldEqual, 3)
+	})
+}
+
+func TestMsgEventsFromDeliverTx(t *testing.T) {
+	Convey("TestMsgEventsFromDeliverTx", t, func() {
+		action := func(typ string) abci.Event {
+			return abci.Event(sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyAction, typ)))
+		}
+
+		res := abci.ResponseDeliverTx{
+			Events: []abci.Event{
+				action("transfer"),
+				abci.Event(sdk.NewEvent("transfer", sdk.NewAttribute("from", "a"))),
+				abci.Event(sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyModule, "asset"))),
+				action("create"),
+				action("transfer"),
+				abci.Event(sdk.NewEvent("transfer", sdk.NewAttribute("from", "b"))),
+			},
+		}
+
+		msgEvents := msgEventsFromDeliverTx(res)
+		So(msgEvents, ShouldHaveLength, 3)
+		So(msgEvents[0], ShouldHaveLength, 2)
+		So(msgEvents[0][1].Type, ShouldEqual, sdk.EventTypeMessage)
+		So(msgEvents[1], ShouldBeEmpty)
+		So(msgEvents[2], ShouldHaveLength, 1)
+		So(string(msgEvents[2][0].Attributes[0].Value), ShouldEqual, "b")
+	})
+}
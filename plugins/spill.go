@@ -0,0 +1,251 @@
+package plugins
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/KuChainNetwork/kuchain/plugins/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// types of the msgs spilled to disk
+const (
+	spilledTxResult   = "tx_result"
+	spilledBeginBlock = "begin_block"
+	spilledEvents     = "events"
+	spilledEndBlock   = "end_block"
+	spilledCommit     = "commit"
+)
+
+// spilledMsg is a plugin msg in the form spilled to disk
+type spilledMsg struct {
+	Type string `json:"type"`
+
+	ChainID  string           `json:"chain_id"`
+	Height   int64            `json:"height"`
+	Time     time.Time        `json:"time"`
+	Proposer []byte           `json:"proposer"`
+	TxHash   tmbytes.HexBytes `json:"tx_hash"`
+	TxIndex  int              `json:"tx_index"`
+	MsgIndex int              `json:"msg_index"`
+
+	// Tx the amino encoded tx of the tx result
+	Tx     []byte         `json:"tx,omitempty"`
+	Result *spilledResult `json:"result,omitempty"`
+
+	// Header and EndBlock are proto encoded
+	Header   []byte `json:"header,omitempty"`
+	EndBlock []byte `json:"end_block,omitempty"`
+	AppHash  []byte `json:"app_hash,omitempty"`
+
+	// Events the events of the block events msg
+	Events []types.Event `json:"events,omitempty"`
+}
+
+// spilledResult is the tx result without the tx
+type spilledResult struct {
+	Code      uint32          `json:"code"`
+	Codespace string          `json:"codespace"`
+	Log       string          `json:"log"`
+	GasWanted int64           `json:"gas_wanted"`
+	GasUsed   int64           `json:"gas_used"`
+	MsgEvents [][]types.Event `json:"msg_events"`
+	Events    []types.Event   `json:"events"`
+}
+
+func newSpilledMsg(typ string, ctx types.Context) spilledMsg {
+	return spilledMsg{
+		Type:     typ,
+		ChainID:  ctx.ChainID(),
+		Height:   ctx.BlockHeight(),
+		Time:     ctx.BlockTime(),
+		Proposer: ctx.Proposer(),
+		TxHash:   ctx.TxHash(),
+		TxIndex:  ctx.TxIndex(),
+		MsgIndex: ctx.MsgIndex(),
+	}
+}
+
+func (s spilledMsg) context() types.Context {
+	return types.NewContext(nil).
+		WithChainID(s.ChainID).
+		WithBlockHeight(s.Height).
+		WithBlockTime(s.Time).
+		WithProposer(s.Proposer).
+		WithTx(s.TxHash, s.TxIndex).
+		WithMsgIndex(s.MsgIndex)
+}
+
+func encodeSpilledMsg(cdc *codec.Codec, msg pluginMsg) ([]byte, error) {
+	var (
+		res spilledMsg
+		err error
+	)
+
+	switch msg := msg.(type) {
+	case *types.MsgTxResult:
+		res = newSpilledMsg(spilledTxResult, msg.Ctx)
+		if res.Tx, err = cdc.MarshalBinaryBare(msg.Result.Tx); err != nil {
+			return nil, err
+		}
+		res.Result = &spilledResult{
+			Code:      msg.Result.Code,
+			Codespace: msg.Result.Codespace,
+			Log:       msg.Result.Log,
+			GasWanted: msg.Result.GasWanted,
+			GasUsed:   msg.Result.GasUsed,
+			MsgEvents: msg.Result.MsgEvents,
+			Events:    msg.Result.Events,
+		}
+	case *types.MsgBeginBlock:
+		res = newSpilledMsg(spilledBeginBlock, msg.Ctx)
+		if res.Header, err = msg.Header.Marshal(); err != nil {
+			return nil, err
+		}
+	case *types.MsgBlockEvents:
+		res = newSpilledMsg(spilledEvents, msg.Ctx)
+		res.Events = msg.Events
+	case *types.MsgEndBlock:
+		res = newSpilledMsg(spilledEndBlock, msg.Ctx)
+		if res.EndBlock, err = msg.Req.Marshal(); err != nil {
+			return nil, err
+		}
+	case *types.MsgCommit:
+		res = newSpilledMsg(spilledCommit, msg.Ctx)
+		res.AppHash = msg.AppHash
+	default:
+		return nil, fmt.Errorf("unknown plugin msg type %T", msg)
+	}
+
+	return json.Marshal(res)
+}
+
+func decodeSpilledMsg(cdc *codec.Codec, bz []byte) (pluginMsg, error) {
+	var msg spilledMsg
+	if err := json.Unmarshal(bz, &msg); err != nil {
+		return nil, err
+	}
+
+	ctx := msg.context()
+
+	switch msg.Type {
+	case spilledTxResult:
+		if msg.Result == nil {
+			return nil, fmt.Errorf("no result in spilled tx result")
+		}
+
+		result := types.TxResult{
+			Code:      msg.Result.Code,
+			Codespace: msg.Result.Codespace,
+			Log:       msg.Result.Log,
+			GasWanted: msg.Result.GasWanted,
+			GasUsed:   msg.Result.GasUsed,
+			MsgEvents: msg.Result.MsgEvents,
+			Events:    msg.Result.Events,
+		}
+		if err := cdc.UnmarshalBinaryBare(msg.Tx, &result.Tx); err != nil {
+			return nil, err
+		}
+
+		return types.NewMsgTxResult(ctx, result), nil
+	case spilledBeginBlock:
+		var header abci.Header
+		if err := header.Unmarshal(msg.Header); err != nil {
+			return nil, err
+		}
+
+		return types.NewMsgBeginBlock(ctx, header), nil
+	case spilledEvents:
+		return types.NewMsgBlockEvents(ctx, msg.Events), nil
+	case spilledEndBlock:
+		var req abci.RequestEndBlock
+		if err := req.Unmarshal(msg.EndBlock); err != nil {
+			return nil, err
+		}
+
+		return types.NewMsgEndBlock(ctx, req), nil
+	case spilledCommit:
+		return types.NewMsgCommit(ctx, msg.AppHash), nil
+	}
+
+	return nil, fmt.Errorf("unknown spilled msg type %s", msg.Type)
+}
+
+// spillStore a fifo of plugin msgs on disk, it is not thread-safe
+type spillStore struct {
+	cdc  *codec.Codec
+	db   dbm.DB
+	head uint64
+	tail uint64
+}
+
+// newSpillStore opens the spill store of a plugin in dir, msgs spilled by the last run
+// are removed as the plugin will not handle them
+func newSpillStore(cdc *codec.Codec, name, dir string) (*spillStore, error) {
+	if err := os.RemoveAll(filepath.Join(dir, name+".db")); err != nil {
+		return nil, err
+	}
+
+	db, err := dbm.NewGoLevelDB(name, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &spillStore{
+		cdc: cdc,
+		db:  db,
+	}, nil
+}
+
+func spillKey(seq uint64) []byte {
+	return sdk.Uint64ToBigEndian(seq)
+}
+
+func (s *spillStore) len() int {
+	return int(s.tail - s.head)
+}
+
+func (s *spillStore) push(msg pluginMsg) error {
+	bz, err := encodeSpilledMsg(s.cdc, msg)
+	if err != nil {
+		return err
+	}
+
+	if err := s.db.Set(spillKey(s.tail), bz); err != nil {
+		return err
+	}
+
+	s.tail++
+	return nil
+}
+
+// pop pops the first msg, returns a nil msg if the store is empty
+func (s *spillStore) pop() (pluginMsg, error) {
+	if s.len() == 0 {
+		return nil, nil
+	}
+
+	key := spillKey(s.head)
+	bz, err := s.db.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	s.head++
+	if err := s.db.Delete(key); err != nil {
+		return nil, err
+	}
+
+	return decodeSpilledMsg(s.cdc, bz)
+}
+
+func (s *spillStore) close() error {
+	return s.db.Close()
+}
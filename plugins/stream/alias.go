@@ -0,0 +1,15 @@
+package stream
+
+import "github.com/KuChainNetwork/kuchain/plugins/stream/types"
+
+const (
+	PluginName = types.PluginName
+
+	SinkFile = types.SinkFile
+	SinkUnix = types.SinkUnix
+	SinkNats = types.SinkNats
+)
+
+type (
+	Config = types.Config
+)
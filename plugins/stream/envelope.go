@@ -0,0 +1,187 @@
+package stream
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/KuChainNetwork/kuchain/plugins/stream/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// EnvelopeVersion is the version of the envelope format, increased on incompatible changes
+const EnvelopeVersion = 1
+
+// Types of the data in envelopes
+const (
+	EnvelopeBlock  = "block"
+	EnvelopeTx     = "tx"
+	EnvelopeEvent  = "event"
+	EnvelopeCommit = "commit"
+)
+
+// Envelope wraps the data streamed to sinks. The key is unique for each envelope and stable
+// across replays, so consumers can drop the duplicates caused by at-least-once delivery.
+type Envelope struct {
+	Version    int             `json:"version"`
+	Key        string          `json:"key"`
+	Type       string          `json:"type"`
+	ChainID    string          `json:"chain_id"`
+	Height     int64           `json:"height"`
+	Time       time.Time       `json:"time"`
+	TxIndex    int             `json:"tx_index"`
+	MsgIndex   int             `json:"msg_index"`
+	EventIndex int             `json:"event_index"`
+	Data       json.RawMessage `json:"data"`
+}
+
+// EnvelopeKey returns the key of the envelope, which is "<height>" for the block, "<height>/<tx index>"
+// for txs, "<height>/<tx index>/<event index>" for events and "<height>/commit" for the commit.
+func EnvelopeKey(typ string, height int64, txIndex, eventIndex int) string {
+	switch typ {
+	case EnvelopeBlock:
+		return fmt.Sprintf("%d", height)
+	case EnvelopeTx:
+		return fmt.Sprintf("%d/%d", height, txIndex)
+	case EnvelopeEvent:
+		return fmt.Sprintf("%d/%d/%d", height, txIndex, eventIndex)
+	default:
+		return fmt.Sprintf("%d/%s", height, typ)
+	}
+}
+
+// BlockData data of the block envelope
+type BlockData struct {
+	Proposer      string `json:"proposer"`
+	LastBlockHash string `json:"last_block_hash"`
+	DataHash      string `json:"data_hash"`
+}
+
+// TxData data of the tx envelope
+type TxData struct {
+	Hash      string          `json:"hash"`
+	Code      uint32          `json:"code"`
+	Codespace string          `json:"codespace"`
+	Log       string          `json:"log"`
+	GasWanted int64           `json:"gas_wanted"`
+	GasUsed   int64           `json:"gas_used"`
+	Fee       string          `json:"fee"`
+	Memo      string          `json:"memo"`
+	Raw       json.RawMessage `json:"raw,omitempty"`
+}
+
+// EventData data of the event envelope
+type EventData struct {
+	TxHash     string            `json:"tx_hash"`
+	Type       string            `json:"type"`
+	Attributes map[string]string `json:"attributes"`
+}
+
+// CommitData data of the commit envelope
+type CommitData struct {
+	AppHash string `json:"app_hash"`
+	NumTxs  int    `json:"num_txs"`
+}
+
+// blockEnvelopes builds the envelopes of a block, which are written to the sink when the block committed
+type blockEnvelopes struct {
+	ctx    types.Context
+	numTxs int
+	msgs   []*Envelope
+}
+
+func newBlockEnvelopes(ctx types.Context, header abci.Header) (*blockEnvelopes, error) {
+	b := &blockEnvelopes{
+		ctx: ctx.WithChainID(header.ChainID).WithBlockTime(header.Time),
+	}
+
+	err := b.add(EnvelopeBlock, -1, -1, -1, BlockData{
+		Proposer:      sdk.ConsAddress(header.ProposerAddress).String(),
+		LastBlockHash: fmt.Sprintf("%X", header.LastBlockId.Hash),
+		DataHash:      fmt.Sprintf("%X", header.DataHash),
+	})
+
+	return b, err
+}
+
+func (b *blockEnvelopes) add(typ string, txIndex, msgIndex, eventIndex int, data interface{}) error {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	height := b.ctx.BlockHeight()
+	b.msgs = append(b.msgs, &Envelope{
+		Version:    EnvelopeVersion,
+		Key:        EnvelopeKey(typ, height, txIndex, eventIndex),
+		Type:       typ,
+		ChainID:    b.ctx.ChainID(),
+		Height:     height,
+		Time:       b.ctx.BlockTime(),
+		TxIndex:    txIndex,
+		MsgIndex:   msgIndex,
+		EventIndex: eventIndex,
+		Data:       raw,
+	})
+
+	return nil
+}
+
+// addTxResult adds the envelopes of the tx and its events, events are only emitted by txs succeeded
+func (b *blockEnvelopes) addTxResult(ctx types.Context, result types.TxResult) error {
+	var (
+		tx      = result.Tx
+		txHash  = ctx.TxHash().String()
+		txIndex = ctx.TxIndex()
+	)
+
+	data := TxData{
+		Hash:      txHash,
+		Code:      result.Code,
+		Codespace: result.Codespace,
+		Log:       result.Log,
+		GasWanted: result.GasWanted,
+		GasUsed:   result.GasUsed,
+		Fee:       tx.Fee.Amount.String(),
+		Memo:      tx.Memo,
+	}
+
+	if len(tx.Msgs) > 0 && ctx.Codec() != nil {
+		if raw, err := tx.PrettifyJSON(ctx.Codec()); err == nil {
+			data.Raw = raw
+		} else {
+			ctx.Logger().Error("prettify tx error", "hash", txHash, "err", err)
+		}
+	}
+
+	b.numTxs++
+	if err := b.add(EnvelopeTx, txIndex, -1, -1, data); err != nil {
+		return err
+	}
+
+	eventIndex := 0
+	for msgIndex, evts := range result.MsgEvents {
+		for _, evt := range evts {
+			err := b.add(EnvelopeEvent, txIndex, msgIndex, eventIndex, EventData{
+				TxHash:     txHash,
+				Type:       evt.Type,
+				Attributes: evt.Attributes,
+			})
+			if err != nil {
+				return err
+			}
+			eventIndex++
+		}
+	}
+
+	return nil
+}
+
+// commit adds the commit envelope, which is the last one of the block
+func (b *blockEnvelopes) commit(appHash []byte) error {
+	return b.add(EnvelopeCommit, -1, -1, -1, CommitData{
+		AppHash: fmt.Sprintf("%X", appHash),
+		NumTxs:  b.numTxs,
+	})
+}
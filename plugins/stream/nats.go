@@ -0,0 +1,146 @@
+package stream
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+)
+
+// natsSink publishes the envelopes to a subject by the nats client protocol. After the envelopes
+// are published, a PING is sent and the write returns once the PONG received, as the server
+// handles the protocol msgs in order, all envelopes are accepted by the server then.
+type natsSink struct {
+	address string
+	subject string
+
+	conn   net.Conn
+	reader *bufio.Reader
+	writer *bufio.Writer
+}
+
+func newNatsSink(address, subject string) *natsSink {
+	return &natsSink{
+		address: address,
+		subject: subject,
+	}
+}
+
+func (s *natsSink) connect() error {
+	conn, err := net.DialTimeout("tcp", s.address, ioTimeout)
+	if err != nil {
+		return err
+	}
+
+	s.conn = conn
+	s.reader = bufio.NewReader(conn)
+	s.writer = bufio.NewWriter(conn)
+
+	if err := conn.SetDeadline(time.Now().Add(ioTimeout)); err != nil {
+		return err
+	}
+
+	// the server sends INFO first once connected
+	line, err := s.readLine()
+	if err != nil {
+		return err
+	}
+
+	if !strings.HasPrefix(line, "INFO") {
+		return fmt.Errorf("unexpected nats server msg: %s", line)
+	}
+
+	_, err = s.writer.WriteString("CONNECT {\"verbose\":false,\"pedantic\":false,\"name\":\"kuchain\"}\r\n")
+	return err
+}
+
+func (s *natsSink) readLine() (string, error) {
+	line, err := s.reader.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+func (s *natsSink) Write(msgs []*Envelope) error {
+	if s.conn == nil {
+		if err := s.connect(); err != nil {
+			s.reset()
+			return err
+		}
+	}
+
+	if err := s.publish(msgs); err != nil {
+		s.reset()
+		return err
+	}
+
+	return nil
+}
+
+func (s *natsSink) publish(msgs []*Envelope) error {
+	if err := s.conn.SetDeadline(time.Now().Add(ioTimeout)); err != nil {
+		return err
+	}
+
+	for _, msg := range msgs {
+		data, err := json.Marshal(msg)
+		if err != nil {
+			return err
+		}
+
+		if _, err := fmt.Fprintf(s.writer, "PUB %s %d\r\n%s\r\n", s.subject, len(data), data); err != nil {
+			return err
+		}
+	}
+
+	if _, err := s.writer.WriteString("PING\r\n"); err != nil {
+		return err
+	}
+
+	if err := s.writer.Flush(); err != nil {
+		return err
+	}
+
+	for {
+		line, err := s.readLine()
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case line == "PONG":
+			return nil
+		case line == "PING":
+			if _, err := s.writer.WriteString("PONG\r\n"); err != nil {
+				return err
+			}
+			if err := s.writer.Flush(); err != nil {
+				return err
+			}
+		case strings.HasPrefix(line, "-ERR"):
+			return fmt.Errorf("nats server error: %s", strings.TrimSpace(strings.TrimPrefix(line, "-ERR")))
+		}
+	}
+}
+
+func (s *natsSink) reset() {
+	if s.conn != nil {
+		s.conn.Close()
+	}
+
+	s.conn = nil
+	s.reader = nil
+	s.writer = nil
+}
+
+func (s *natsSink) Close() error {
+	if s.conn == nil {
+		return nil
+	}
+
+	return s.conn.Close()
+}
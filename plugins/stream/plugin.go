@@ -0,0 +1,180 @@
+package stream
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/KuChainNetwork/kuchain/plugins"
+	"github.com/KuChainNetwork/kuchain/plugins/stream/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+const (
+	// retry interval when write to sink failed, doubled each retry up to maxRetryInterval
+	minRetryInterval = 500 * time.Millisecond
+	maxRetryInterval = 30 * time.Second
+)
+
+// plugin streams the blocks, txs and events to a sink. The envelopes of a block are written when the
+// block committed, and the height is acked by the plugins framework only after the write succeeded,
+// blocks not acked are replayed on startup, so each envelope is delivered at least once.
+type plugin struct {
+	logger log.Logger
+
+	cfg  types.Config
+	sink Sink
+
+	// block the envelopes of the block in processing, only used in the goroutine of plugin
+	block *blockEnvelopes
+}
+
+func (t *plugin) Init(ctx types.Context) error {
+	t.logger.Info("plugin init", "name", types.PluginName)
+
+	sink, err := NewSink(t.cfg)
+	if err != nil {
+		return err
+	}
+
+	t.sink = sink
+	return nil
+}
+
+func (t *plugin) Start(ctx types.Context) error {
+	t.logger.Info("plugin start", "name", types.PluginName, "sink", t.cfg.Sink)
+	return nil
+}
+
+func (t *plugin) Stop(ctx types.Context) error {
+	t.logger.Info("plugin stop", "name", types.PluginName)
+	return t.sink.Close()
+}
+
+func (t *plugin) MsgHandler() types.PluginMsgHandler {
+	return nil
+}
+
+func (t *plugin) TxHandler() types.PluginTxHandler {
+	return nil
+}
+
+func (t *plugin) EvtHandler() types.PluginEvtHandler {
+	return nil
+}
+
+func (t *plugin) TxResultHandler() types.PluginTxResultHandler {
+	return func(ctx types.Context, result types.TxResult) {
+		t.OnTxResult(ctx, result)
+	}
+}
+
+func (t *plugin) BeginBlockHandler() types.PluginBeginBlockHandler {
+	return func(ctx types.Context, header abci.Header) {
+		t.OnBeginBlock(ctx, header)
+	}
+}
+
+func (t *plugin) EndBlockHandler() types.PluginEndBlockHandler {
+	return nil
+}
+
+func (t *plugin) CommitHandler() types.PluginCommitHandler {
+	return func(ctx types.Context, appHash []byte) {
+		t.OnCommit(ctx, appHash)
+	}
+}
+
+func (t *plugin) OnBeginBlock(ctx types.Context, header abci.Header) {
+	block, err := newBlockEnvelopes(ctx, header)
+	if err != nil {
+		panic(fmt.Errorf("encode block %d error: %v", header.Height, err))
+	}
+
+	t.block = block
+}
+
+func (t *plugin) OnTxResult(ctx types.Context, result types.TxResult) {
+	if t.block == nil {
+		t.logger.Error("tx result without block begun", "height", ctx.BlockHeight(), "hash", ctx.TxHash())
+		return
+	}
+
+	if err := t.block.addTxResult(ctx, result); err != nil {
+		panic(fmt.Errorf("encode tx %s error: %v", ctx.TxHash(), err))
+	}
+}
+
+// OnCommit writes the envelopes of the block to the sink, if all retries failed, the plugin panics
+// so it is disabled by the plugins framework without acking the block.
+func (t *plugin) OnCommit(ctx types.Context, appHash []byte) {
+	if t.block == nil {
+		t.logger.Error("commit without block begun", "height", ctx.BlockHeight())
+		return
+	}
+
+	block := t.block
+	t.block = nil
+
+	if err := block.commit(appHash); err != nil {
+		panic(fmt.Errorf("encode commit %d error: %v", ctx.BlockHeight(), err))
+	}
+
+	if err := t.writeWithRetry(block.msgs); err != nil {
+		panic(fmt.Errorf("write block %d to sink error: %v", ctx.BlockHeight(), err))
+	}
+}
+
+func (t *plugin) writeWithRetry(msgs []*Envelope) error {
+	interval := minRetryInterval
+
+	for retry := 0; ; retry++ {
+		err := t.sink.Write(msgs)
+		if err == nil || retry >= t.cfg.MaxRetries {
+			return err
+		}
+
+		t.logger.Error("write to sink error, retry", "err", err, "after", interval)
+		time.Sleep(interval)
+
+		if interval *= 2; interval > maxRetryInterval {
+			interval = maxRetryInterval
+		}
+	}
+}
+
+func (t *plugin) Logger() log.Logger {
+	return t.logger
+}
+
+func (t *plugin) Name() string {
+	return types.PluginName
+}
+
+// New new plugin
+func New(ctx types.Context, cfg types.BaseCfg) (*plugin, error) {
+	logger := ctx.Logger().With("module", fmt.Sprintf("plugins/%s", types.PluginName))
+
+	res := &plugin{
+		logger: logger,
+	}
+
+	if err := cfg.UnmarshalData(&res.cfg); err != nil {
+		return nil, err
+	}
+
+	res.cfg = res.cfg.WithDefault()
+	if err := res.cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	logger.Info("new plugin", "name", types.PluginName, "cfg", res.cfg)
+
+	return res, nil
+}
+
+func init() {
+	plugins.Register(types.PluginName, func(ctx plugins.Context, cfg plugins.BaseCfg) (plugins.Plugin, error) {
+		return New(ctx, cfg)
+	})
+}
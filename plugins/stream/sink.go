@@ -0,0 +1,131 @@
+package stream
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"time"
+
+	"github.com/KuChainNetwork/kuchain/plugins/stream/types"
+)
+
+const (
+	// ioTimeout timeout of dialing and io to the sinks on network
+	ioTimeout = 10 * time.Second
+)
+
+// Sink is where the envelopes are streamed to, the envelopes of a block are written in a batch
+type Sink interface {
+	// Write writes the envelopes in order, returns nil only after all of them accepted by the sink
+	Write(msgs []*Envelope) error
+	Close() error
+}
+
+// NewSink creates the sink by the config
+func NewSink(cfg types.Config) (Sink, error) {
+	switch cfg.Sink {
+	case types.SinkFile:
+		return newFileSink(cfg.Path)
+	case types.SinkUnix:
+		return newUnixSink(cfg.Path), nil
+	case types.SinkNats:
+		return newNatsSink(cfg.Address, cfg.Subject), nil
+	default:
+		return nil, fmt.Errorf("unknown sink %q", cfg.Sink)
+	}
+}
+
+// encodeLines encodes the envelopes into json, one per line
+func encodeLines(msgs []*Envelope) ([]byte, error) {
+	var buf bytes.Buffer
+
+	enc := json.NewEncoder(&buf)
+	for _, msg := range msgs {
+		if err := enc.Encode(msg); err != nil {
+			return nil, err
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+// fileSink appends the envelopes to a local file, the file is synced after each write
+type fileSink struct {
+	file *os.File
+}
+
+func newFileSink(path string) (*fileSink, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fileSink{file: file}, nil
+}
+
+func (s *fileSink) Write(msgs []*Envelope) error {
+	data, err := encodeLines(msgs)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.file.Write(data); err != nil {
+		return err
+	}
+
+	return s.file.Sync()
+}
+
+func (s *fileSink) Close() error {
+	return s.file.Close()
+}
+
+// unixSink writes the envelopes to a unix socket, it connects on demand and reconnects after errors
+type unixSink struct {
+	path string
+	conn net.Conn
+}
+
+func newUnixSink(path string) *unixSink {
+	return &unixSink{path: path}
+}
+
+func (s *unixSink) Write(msgs []*Envelope) error {
+	data, err := encodeLines(msgs)
+	if err != nil {
+		return err
+	}
+
+	if s.conn == nil {
+		if s.conn, err = net.DialTimeout("unix", s.path, ioTimeout); err != nil {
+			return err
+		}
+	}
+
+	if err := s.conn.SetWriteDeadline(time.Now().Add(ioTimeout)); err != nil {
+		s.reset()
+		return err
+	}
+
+	if _, err := s.conn.Write(data); err != nil {
+		s.reset()
+		return err
+	}
+
+	return nil
+}
+
+func (s *unixSink) reset() {
+	s.conn.Close()
+	s.conn = nil
+}
+
+func (s *unixSink) Close() error {
+	if s.conn == nil {
+		return nil
+	}
+
+	return s.conn.Close()
+}
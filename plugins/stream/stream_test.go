@@ -0,0 +1,314 @@
+package stream
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/plugins/stream/types"
+	. "github.com/smartystreets/goconvey/convey"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// fakeBroker is an in-process server of the subset of nats protocol used by the nats sink,
+// it closes the first dropConns connections after the first PUB to simulate broker failures.
+type fakeBroker struct {
+	listener  net.Listener
+	dropConns int
+
+	mu       sync.Mutex
+	subjects []string
+	payloads [][]byte
+}
+
+func newFakeBroker(dropConns int) (*fakeBroker, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	b := &fakeBroker{
+		listener:  listener,
+		dropConns: dropConns,
+	}
+
+	go b.serve()
+	return b, nil
+}
+
+func (b *fakeBroker) serve() {
+	for conns := 0; ; conns++ {
+		conn, err := b.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		go b.handle(conn, conns < b.dropConns)
+	}
+}
+
+func (b *fakeBroker) handle(conn net.Conn, drop bool) {
+	defer conn.Close()
+
+	reader := bufio.NewReader(conn)
+	fmt.Fprintf(conn, "INFO {\"server_id\":\"fake\"}\r\n")
+
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch fields[0] {
+		case "PING":
+			fmt.Fprintf(conn, "PONG\r\n")
+		case "PUB":
+			if drop {
+				return
+			}
+
+			size, _ := strconv.Atoi(fields[len(fields)-1])
+			payload := make([]byte, size+2)
+			if _, err := readFull(reader, payload); err != nil {
+				return
+			}
+
+			b.mu.Lock()
+			b.subjects = append(b.subjects, fields[1])
+			b.payloads = append(b.payloads, payload[:size])
+			b.mu.Unlock()
+		}
+	}
+}
+
+func readFull(reader *bufio.Reader, buf []byte) (int, error) {
+	n := 0
+	for n < len(buf) {
+		m, err := reader.Read(buf[n:])
+		if err != nil {
+			return n, err
+		}
+		n += m
+	}
+	return n, nil
+}
+
+func (b *fakeBroker) keys() []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	res := make([]string, 0, len(b.payloads))
+	for _, payload := range b.payloads {
+		var env Envelope
+		if err := json.Unmarshal(payload, &env); err != nil {
+			panic(err)
+		}
+		res = append(res, env.Key)
+	}
+
+	return res
+}
+
+func newTestPlugin(cfg types.Config) (*plugin, error) {
+	raw, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := types.NewContext(log.NewNopLogger())
+	p, err := New(ctx, types.BaseCfg{Name: types.PluginName, CfgRaw: raw})
+	if err != nil {
+		return nil, err
+	}
+
+	return p, p.Init(ctx)
+}
+
+// streamBlock streams a block with a tx which emits two events
+func streamBlock(p *plugin, height int64) {
+	ctx := types.NewContext(log.NewNopLogger()).WithBlockHeight(height)
+
+	p.OnBeginBlock(ctx, abci.Header{ChainID: "testing", Height: height})
+	p.OnTxResult(ctx.WithTx([]byte("hash"), 0), types.TxResult{
+		Tx: chainTypes.StdTx{Memo: "memo"},
+		MsgEvents: [][]types.Event{{
+			{Type: "message", Attributes: map[string]string{"action": "transfer"}},
+			{Type: "transfer", Attributes: map[string]string{"amount": "1kuchain/kcs"}},
+		}},
+	})
+	p.OnCommit(ctx, []byte("apphash"))
+}
+
+func blockKeys(height int64) []string {
+	return []string{
+		fmt.Sprintf("%d", height),
+		fmt.Sprintf("%d/0", height),
+		fmt.Sprintf("%d/0/0", height),
+		fmt.Sprintf("%d/0/1", height),
+		fmt.Sprintf("%d/commit", height),
+	}
+}
+
+func TestStreamConfig(t *testing.T) {
+	Convey("TestStreamConfig", t, func() {
+		So(types.Config{Sink: SinkFile, Path: "a.jsonl"}.Validate(), ShouldBeNil)
+		So(types.Config{Sink: SinkNats, Address: ":4222", Subject: "blocks"}.Validate(), ShouldBeNil)
+
+		So(types.Config{Sink: "kafka"}.Validate(), ShouldNotBeNil)
+		So(types.Config{Sink: SinkUnix}.Validate(), ShouldNotBeNil)
+		So(types.Config{Sink: SinkNats, Address: ":4222"}.Validate(), ShouldNotBeNil)
+
+		_, err := newTestPlugin(types.Config{Sink: SinkFile})
+		So(err, ShouldNotBeNil)
+	})
+}
+
+func TestFileSink(t *testing.T) {
+	Convey("TestFileSink", t, func() {
+		dir, err := ioutil.TempDir("", "stream")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+
+		path := filepath.Join(dir, "stream.jsonl")
+		p, err := newTestPlugin(types.Config{Sink: SinkFile, Path: path})
+		So(err, ShouldBeNil)
+
+		streamBlock(p, 1)
+		streamBlock(p, 2)
+		So(p.Stop(types.NewContext(log.NewNopLogger())), ShouldBeNil)
+
+		data, err := ioutil.ReadFile(path)
+		So(err, ShouldBeNil)
+
+		lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+		So(lines, ShouldHaveLength, 10)
+
+		keys := make([]string, 0, len(lines))
+		for _, line := range lines {
+			var env Envelope
+			So(json.Unmarshal([]byte(line), &env), ShouldBeNil)
+			So(env.Version, ShouldEqual, EnvelopeVersion)
+			So(env.ChainID, ShouldEqual, "testing")
+			keys = append(keys, env.Key)
+		}
+
+		So(keys, ShouldResemble, append(blockKeys(1), blockKeys(2)...))
+
+		var evt Envelope
+		So(json.Unmarshal([]byte(lines[3]), &evt), ShouldBeNil)
+		So(evt.Type, ShouldEqual, EnvelopeEvent)
+		So(evt.TxIndex, ShouldEqual, 0)
+		So(evt.EventIndex, ShouldEqual, 1)
+
+		var evtData EventData
+		So(json.Unmarshal(evt.Data, &evtData), ShouldBeNil)
+		So(evtData.Type, ShouldEqual, "transfer")
+		So(evtData.Attributes["amount"], ShouldEqual, "1kuchain/kcs")
+	})
+}
+
+func TestUnixSink(t *testing.T) {
+	Convey("TestUnixSink", t, func() {
+		dir, err := ioutil.TempDir("", "stream")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+
+		path := filepath.Join(dir, "stream.sock")
+		listener, err := net.Listen("unix", path)
+		So(err, ShouldBeNil)
+		defer listener.Close()
+
+		lines := make(chan string, 16)
+		go func() {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+
+			scanner := bufio.NewScanner(conn)
+			for scanner.Scan() {
+				lines <- scanner.Text()
+			}
+		}()
+
+		p, err := newTestPlugin(types.Config{Sink: SinkUnix, Path: path})
+		So(err, ShouldBeNil)
+
+		streamBlock(p, 1)
+
+		keys := make([]string, 0, 5)
+		for range blockKeys(1) {
+			var env Envelope
+			So(json.Unmarshal([]byte(<-lines), &env), ShouldBeNil)
+			keys = append(keys, env.Key)
+		}
+
+		So(keys, ShouldResemble, blockKeys(1))
+		So(p.Stop(types.NewContext(log.NewNopLogger())), ShouldBeNil)
+	})
+}
+
+func TestNatsSink(t *testing.T) {
+	Convey("TestNatsSink", t, func() {
+		broker, err := newFakeBroker(0)
+		So(err, ShouldBeNil)
+		defer broker.listener.Close()
+
+		p, err := newTestPlugin(types.Config{Sink: SinkNats, Address: broker.listener.Addr().String(), Subject: "kuchain.blocks"})
+		So(err, ShouldBeNil)
+
+		streamBlock(p, 1)
+		streamBlock(p, 2)
+		So(p.Stop(types.NewContext(log.NewNopLogger())), ShouldBeNil)
+
+		So(broker.keys(), ShouldResemble, append(blockKeys(1), blockKeys(2)...))
+		So(broker.subjects[0], ShouldEqual, "kuchain.blocks")
+	})
+
+	Convey("TestNatsSinkRetry", t, func() {
+		broker, err := newFakeBroker(1)
+		So(err, ShouldBeNil)
+		defer broker.listener.Close()
+
+		p, err := newTestPlugin(types.Config{Sink: SinkNats, Address: broker.listener.Addr().String(), Subject: "kuchain.blocks"})
+		So(err, ShouldBeNil)
+
+		// the first connection is dropped, the block is written again by a new connection
+		streamBlock(p, 1)
+		So(p.Stop(types.NewContext(log.NewNopLogger())), ShouldBeNil)
+
+		So(broker.keys(), ShouldResemble, blockKeys(1))
+	})
+
+	Convey("TestNatsSinkGiveUp", t, func() {
+		broker, err := newFakeBroker(10)
+		So(err, ShouldBeNil)
+		defer broker.listener.Close()
+
+		p, err := newTestPlugin(types.Config{Sink: SinkNats, Address: broker.listener.Addr().String(), Subject: "kuchain.blocks", MaxRetries: -1})
+		So(err, ShouldNotBeNil)
+
+		p, err = newTestPlugin(types.Config{Sink: SinkNats, Address: broker.listener.Addr().String(), Subject: "kuchain.blocks", MaxRetries: 1})
+		So(err, ShouldBeNil)
+
+		// panics after all retries failed, so the block will not be acked by the plugins framework
+		So(func() { streamBlock(p, 1) }, ShouldPanic)
+		So(broker.keys(), ShouldBeEmpty)
+	})
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/plugins/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+type (
+	Context          = types.Context
+	Event            = types.Event
+	TxResult         = types.TxResult
+	BaseCfg          = types.BaseCfg
+	PluginMsgHandler = types.PluginMsgHandler
+	PluginTxHandler  = types.PluginTxHandler
+	PluginEvtHandler = types.PluginEvtHandler
+
+	PluginTxResultHandler   = types.PluginTxResultHandler
+	PluginBeginBlockHandler = types.PluginBeginBlockHandler
+	PluginEndBlockHandler   = types.PluginEndBlockHandler
+	PluginCommitHandler     = types.PluginCommitHandler
+)
+
+var (
+	NewContext = types.NewContext
+)
+
+func Logger(ctx Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("plugins/%s", PluginName))
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"fmt"
+)
+
+// Sinks the stream plugin can write to
+const (
+	// SinkFile appends the envelopes to a local file, one json per line
+	SinkFile = "file"
+	// SinkUnix writes the envelopes to a unix socket, one json per line
+	SinkUnix = "unix"
+	// SinkNats publishes the envelopes to a subject of a nats server
+	SinkNats = "nats"
+
+	DefaultMaxRetries = 10
+)
+
+// Config config for the stream plugin
+type Config struct {
+	// Sink is the type of the sink, one of file, unix and nats
+	Sink string `json:"sink"`
+	// Path is the path of the file for the file sink, or the path of the socket for the unix sink
+	Path string `json:"path"`
+	// Address is the address of the server for the nats sink
+	Address string `json:"address"`
+	// Subject is the subject to publish for the nats sink
+	Subject string `json:"subject"`
+	// MaxRetries is the times to retry writing a block to the sink before the plugin gives up
+	MaxRetries int `json:"max_retries"`
+}
+
+// WithDefault returns the config with the default values for fields not set
+func (c Config) WithDefault() Config {
+	if c.MaxRetries == 0 {
+		c.MaxRetries = DefaultMaxRetries
+	}
+
+	return c
+}
+
+// Validate validates the config
+func (c Config) Validate() error {
+	switch c.Sink {
+	case SinkFile, SinkUnix:
+		if c.Path == "" {
+			return fmt.Errorf("path is required by the %s sink", c.Sink)
+		}
+	case SinkNats:
+		if c.Address == "" || c.Subject == "" {
+			return fmt.Errorf("address and subject are required by the %s sink", c.Sink)
+		}
+	default:
+		return fmt.Errorf("unknown sink %q", c.Sink)
+	}
+
+	if c.MaxRetries < 0 {
+		return fmt.Errorf("max retries cannot be negative: %d", c.MaxRetries)
+	}
+
+	return nil
+}
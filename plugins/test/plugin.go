@@ -1,9 +1,13 @@
 package test
 
 import (
+	"fmt"
+
 	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/plugins"
 	"github.com/KuChainNetwork/kuchain/plugins/test/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 )
 
@@ -42,6 +46,22 @@ func (t *testPlugin) OnMsg(ctx types.Context, msg sdk.Msg) {
 	t.logger.Info("on msg", "msg", msg)
 }
 
+func (t *testPlugin) OnTxResult(ctx types.Context, result types.TxResult) {
+	t.logger.Info("on tx result", "hash", ctx.TxHash(), "code", result.Code, "gasUsed", result.GasUsed)
+}
+
+func (t *testPlugin) OnBeginBlock(ctx types.Context, header abci.Header) {
+	t.logger.Info("on begin block", "height", ctx.BlockHeight(), "time", ctx.BlockTime())
+}
+
+func (t *testPlugin) OnEndBlock(ctx types.Context, req abci.RequestEndBlock) {
+	t.logger.Info("on end block", "height", ctx.BlockHeight())
+}
+
+func (t *testPlugin) OnCommit(ctx types.Context, appHash []byte) {
+	t.logger.Info("on commit", "height", ctx.BlockHeight(), "appHash", fmt.Sprintf("%X", appHash))
+}
+
 func (t *testPlugin) MsgHandler() types.PluginMsgHandler {
 	return func(ctx types.Context, msg sdk.Msg) {
 		t.OnMsg(ctx, msg)
@@ -60,6 +80,30 @@ func (t *testPlugin) EvtHandler() types.PluginEvtHandler {
 	}
 }
 
+func (t *testPlugin) TxResultHandler() types.PluginTxResultHandler {
+	return func(ctx types.Context, result types.TxResult) {
+		t.OnTxResult(ctx, result)
+	}
+}
+
+func (t *testPlugin) BeginBlockHandler() types.PluginBeginBlockHandler {
+	return func(ctx types.Context, header abci.Header) {
+		t.OnBeginBlock(ctx, header)
+	}
+}
+
+func (t *testPlugin) EndBlockHandler() types.PluginEndBlockHandler {
+	return func(ctx types.Context, req abci.RequestEndBlock) {
+		t.OnEndBlock(ctx, req)
+	}
+}
+
+func (t *testPlugin) CommitHandler() types.PluginCommitHandler {
+	return func(ctx types.Context, appHash []byte) {
+		t.OnCommit(ctx, appHash)
+	}
+}
+
 func (t *testPlugin) Logger() log.Logger {
 	return t.logger
 }
@@ -74,3 +118,9 @@ func NewTestPlugin(ctx types.Context, cfg types.BaseCfg) *testPlugin {
 		logger: ctx.Logger().With("module", types.PluginName),
 	}
 }
+
+func init() {
+	plugins.Register(types.PluginName, func(ctx plugins.Context, cfg plugins.BaseCfg) (plugins.Plugin, error) {
+		return NewTestPlugin(ctx, cfg), nil
+	})
+}
@@ -1,14 +1,70 @@
 package types
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Overflow policies for the msg queue of a plugin
+const (
+	// OverflowBlock blocks the chain until the plugin handles msgs in its queue
+	OverflowBlock = "block"
+	// OverflowDrop drops the msgs when the queue is full, except commit msgs, the blocks from the first
+	// one with msgs dropped are not acked, it is not supported by plugins acking blocks by themselves
+	OverflowDrop = "drop"
+	// OverflowSpill writes the msgs to disk when the queue is full, and handles them later
+	OverflowSpill = "spill"
+
+	DefaultQueueSize = 512
+)
 
 type BaseCfg struct {
 	Name       string          `json:"name"`
 	ExtCfgFile string          `json:"ext_cfg_file"`
 	CfgRaw     json.RawMessage `json:"cfg"`
+	Queue      QueueCfg        `json:"queue"`
 }
 
 // UnmarshalData unmarshal data to struct
 func (b BaseCfg) UnmarshalData(data interface{}) error {
 	return json.Unmarshal(b.CfgRaw, data)
 }
+
+// QueueCfg config for the msg queue of a plugin
+type QueueCfg struct {
+	Size     int    `json:"size"`
+	Overflow string `json:"overflow"`
+	SpillDir string `json:"spill_dir"`
+}
+
+// WithDefault returns the queue config with the default values for fields not set
+func (q QueueCfg) WithDefault() QueueCfg {
+	if q.Size == 0 {
+		q.Size = DefaultQueueSize
+	}
+
+	if q.Overflow == "" {
+		q.Overflow = OverflowBlock
+	}
+
+	return q
+}
+
+// Validate validates the queue config
+func (q QueueCfg) Validate() error {
+	if q.Size < 0 {
+		return fmt.Errorf("queue size cannot be negative: %d", q.Size)
+	}
+
+	switch q.Overflow {
+	case "", OverflowBlock, OverflowDrop:
+	case OverflowSpill:
+		if q.SpillDir == "" {
+			return fmt.Errorf("spill_dir is required by the %s overflow policy", OverflowSpill)
+		}
+	default:
+		return fmt.Errorf("unknown queue overflow policy %q", q.Overflow)
+	}
+
+	return nil
+}
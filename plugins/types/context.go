@@ -1,7 +1,11 @@
 package types
 
 import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 	"github.com/tendermint/tendermint/libs/log"
 )
 
@@ -9,15 +13,46 @@ import (
 type Context struct {
 	chainID string
 	logger  log.Logger
+	cdc     *codec.Codec
+
+	height   int64
+	time     time.Time
+	proposer sdk.ConsAddress
+	txHash   tmbytes.HexBytes
+	txIndex  int
+	msgIndex int
 }
 
 func (c Context) ChainID() string    { return c.chainID }
 func (c Context) Logger() log.Logger { return c.logger }
 
+// Codec returns the codec of the app, to decode the txs and msgs
+func (c Context) Codec() *codec.Codec { return c.cdc }
+
+// BlockHeight returns the height of the block in processing
+func (c Context) BlockHeight() int64 { return c.height }
+
+// BlockTime returns the time of the block in processing
+func (c Context) BlockTime() time.Time { return c.time }
+
+// Proposer returns the consensus address of the block proposer
+func (c Context) Proposer() sdk.ConsAddress { return c.proposer }
+
+// TxHash returns the hash of the tx in processing, nil if not in a tx
+func (c Context) TxHash() tmbytes.HexBytes { return c.txHash }
+
+// TxIndex returns the index of the tx in the block, -1 if not in a tx
+func (c Context) TxIndex() int { return c.txIndex }
+
+// MsgIndex returns the index of the msg in the tx, -1 if not in a msg
+func (c Context) MsgIndex() int { return c.msgIndex }
+
 // NewContext create a new context
 func NewContext(logger log.Logger) Context {
 	return Context{
-		logger: logger,
+		logger:   logger,
+		txIndex:  -1,
+		msgIndex: -1,
 	}
 }
 
@@ -26,6 +61,48 @@ func (c Context) WithChainID(chainID string) Context {
 	return c
 }
 
+func (c Context) WithLogger(logger log.Logger) Context {
+	c.logger = logger
+	return c
+}
+
+func (c Context) WithCodec(cdc *codec.Codec) Context {
+	c.cdc = cdc
+	return c
+}
+
+func (c Context) WithBlockHeight(height int64) Context {
+	c.height = height
+	return c
+}
+
+func (c Context) WithBlockTime(t time.Time) Context {
+	c.time = t
+	return c
+}
+
+func (c Context) WithProposer(proposer sdk.ConsAddress) Context {
+	c.proposer = proposer
+	return c
+}
+
+func (c Context) WithTx(hash tmbytes.HexBytes, index int) Context {
+	c.txHash = hash
+	c.txIndex = index
+	return c
+}
+
+func (c Context) WithMsgIndex(index int) Context {
+	c.msgIndex = index
+	return c
+}
+
+// NewCtx creates a plugin context with the block info of a sdk context
 func NewCtx(ctx sdk.Context) Context {
-	return NewContext(ctx.Logger()).WithChainID(ctx.ChainID())
+	header := ctx.BlockHeader()
+	return NewContext(ctx.Logger()).
+		WithChainID(ctx.ChainID()).
+		WithBlockHeight(header.Height).
+		WithBlockTime(header.Time).
+		WithProposer(sdk.ConsAddress(header.ProposerAddress))
 }
@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 type Event struct {
@@ -21,3 +22,31 @@ func FromSdkEvent(evt sdk.Event) Event {
 
 	return res
 }
+
+// FromSdkEvents converts the events emitted by modules
+func FromSdkEvents(evts sdk.Events) []Event {
+	if len(evts) == 0 {
+		return nil
+	}
+
+	res := make([]Event, 0, len(evts))
+	for _, evt := range evts {
+		res = append(res, FromSdkEvent(evt))
+	}
+
+	return res
+}
+
+// FromABCIEvents converts the events in abci responses
+func FromABCIEvents(evts []abci.Event) []Event {
+	if len(evts) == 0 {
+		return nil
+	}
+
+	res := make([]Event, 0, len(evts))
+	for _, evt := range evts {
+		res = append(res, FromSdkEvent(sdk.Event(evt)))
+	}
+
+	return res
+}
@@ -1,30 +1,76 @@
 package types
 
 import (
-	"github.com/KuChainNetwork/kuchain/chain/types"
-	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
-// MsgEvent event msg for plugin handler
-type MsgEvent struct {
-	Evt Event
+// MsgTxResult tx result msg for plugin handler
+type MsgTxResult struct {
+	Ctx    Context
+	Result TxResult
 }
 
-// NewMsgEvent new msg event
-func NewMsgEvent(evt sdk.Event) *MsgEvent {
-	return &MsgEvent{
-		Evt: FromSdkEvent(evt),
+// NewMsgTxResult creates a new msg
+func NewMsgTxResult(ctx Context, result TxResult) *MsgTxResult {
+	return &MsgTxResult{
+		Ctx:    ctx,
+		Result: result, // no need deep copy as it will not be changed
 	}
 }
 
-// MsgStdTx stdTx msg for plugin handler
-type MsgStdTx struct {
-	Tx types.StdTx
+// MsgBeginBlock begin block msg for plugin handler
+type MsgBeginBlock struct {
+	Ctx    Context
+	Header abci.Header
 }
 
-// NewMsgStdTx creates a new msg
-func NewMsgStdTx(tx types.StdTx) *MsgStdTx {
-	return &MsgStdTx{
-		Tx: tx, // no need deep copy as it will not be changed
+// NewMsgBeginBlock creates a new begin block msg
+func NewMsgBeginBlock(ctx Context, header abci.Header) *MsgBeginBlock {
+	return &MsgBeginBlock{
+		Ctx:    ctx,
+		Header: header,
+	}
+}
+
+// MsgBlockEvents events emitted out of txs for plugin handler, by the begin or end blockers of
+// modules, or by the genesis which are passed with the events of the first block
+type MsgBlockEvents struct {
+	Ctx    Context
+	Events []Event
+}
+
+// NewMsgBlockEvents creates a new block events msg
+func NewMsgBlockEvents(ctx Context, events []Event) *MsgBlockEvents {
+	return &MsgBlockEvents{
+		Ctx:    ctx,
+		Events: events,
+	}
+}
+
+// MsgEndBlock end block msg for plugin handler
+type MsgEndBlock struct {
+	Ctx Context
+	Req abci.RequestEndBlock
+}
+
+// NewMsgEndBlock creates a new end block msg
+func NewMsgEndBlock(ctx Context, req abci.RequestEndBlock) *MsgEndBlock {
+	return &MsgEndBlock{
+		Ctx: ctx,
+		Req: req,
+	}
+}
+
+// MsgCommit commit msg for plugin handler
+type MsgCommit struct {
+	Ctx     Context
+	AppHash []byte
+}
+
+// NewMsgCommit creates a new commit msg
+func NewMsgCommit(ctx Context, appHash []byte) *MsgCommit {
+	return &MsgCommit{
+		Ctx:     ctx,
+		AppHash: appHash,
 	}
 }
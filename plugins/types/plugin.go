@@ -3,6 +3,7 @@ package types
 import (
 	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 )
 
@@ -10,6 +11,18 @@ type PluginMsgHandler func(ctx Context, msg sdk.Msg)
 type PluginTxHandler func(ctx Context, tx chainTypes.StdTx)
 type PluginEvtHandler func(ctx Context, evt Event)
 
+// PluginTxResultHandler is called with the result of each tx delivered in a block
+type PluginTxResultHandler func(ctx Context, result TxResult)
+
+// PluginBeginBlockHandler is called when a block begins, before any tx in it
+type PluginBeginBlockHandler func(ctx Context, header abci.Header)
+
+// PluginEndBlockHandler is called after all txs in the block are delivered
+type PluginEndBlockHandler func(ctx Context, req abci.RequestEndBlock)
+
+// PluginCommitHandler is called when the block is committed, so it is final
+type PluginCommitHandler func(ctx Context, appHash []byte)
+
 type Plugin interface {
 	Init(Context) error
 	Start(Context) error
@@ -18,7 +31,26 @@ type Plugin interface {
 	EvtHandler() PluginEvtHandler
 	MsgHandler() PluginMsgHandler
 	TxHandler() PluginTxHandler
+	TxResultHandler() PluginTxResultHandler
+
+	BeginBlockHandler() PluginBeginBlockHandler
+	EndBlockHandler() PluginEndBlockHandler
+	CommitHandler() PluginCommitHandler
 
 	Logger() log.Logger
 	Name() string
 }
+
+// PluginAcker is implemented by plugins which persist the height of the last block handled together
+// with their own data, for other plugins the height is persisted by the plugins framework once the
+// commit handler of the block returned. Blocks after the height are replayed to the plugin on startup.
+type PluginAcker interface {
+	// LastAckedHeight returns the height of the last block acknowledged by the plugin, 0 if none
+	LastAckedHeight(ctx Context) (int64, error)
+}
+
+// PluginFlusher is implemented by plugins which handle the blocks asynchronously, Flush returns
+// after the data of all blocks handled are persisted, it is used to make progress durable.
+type PluginFlusher interface {
+	Flush(ctx Context) error
+}
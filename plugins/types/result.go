@@ -0,0 +1,57 @@
+package types
+
+import (
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// TxResult is the result of a tx delivered in a block
+type TxResult struct {
+	Tx        chainTypes.StdTx
+	Code      uint32
+	Codespace string
+	Log       string
+	GasWanted int64
+	GasUsed   int64
+
+	// MsgEvents are the events emitted by each msg of the tx, empty if the tx failed
+	MsgEvents [][]Event
+
+	// Events are all events in the DeliverTx response, including the events of the ante handler,
+	// which are kept even if the msgs failed as the fee is paid, empty if the ante handler failed
+	Events []Event
+}
+
+// NewTxResult creates a tx result from the DeliverTx response and the events of each msg
+func NewTxResult(tx chainTypes.StdTx, res abci.ResponseDeliverTx, msgEvents []sdk.Events) TxResult {
+	result := TxResult{
+		Tx:        tx,
+		Code:      res.Code,
+		Codespace: res.Codespace,
+		Log:       res.Log,
+		GasWanted: res.GasWanted,
+		GasUsed:   res.GasUsed,
+		Events:    FromABCIEvents(res.Events),
+	}
+
+	if !result.IsOK() {
+		return result
+	}
+
+	result.MsgEvents = make([][]Event, 0, len(msgEvents))
+	for _, evts := range msgEvents {
+		events := make([]Event, 0, len(evts))
+		for _, evt := range evts {
+			events = append(events, FromSdkEvent(evt))
+		}
+		result.MsgEvents = append(result.MsgEvents, events)
+	}
+
+	return result
+}
+
+// IsOK returns if the tx is delivered successfully
+func (r TxResult) IsOK() bool {
+	return r.Code == abci.CodeTypeOK
+}
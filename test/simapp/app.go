@@ -171,7 +171,7 @@ func NewSimApp(
 	app.subspaces[mint.ModuleName] = app.paramsKeeper.Subspace(mint.DefaultParamspace)
 	app.subspaces[gov.ModuleName] = app.paramsKeeper.Subspace(gov.DefaultParamspace).WithKeyTable(gov.ParamKeyTable())
 	// add keepers
-	app.accountKeeper = account.NewAccountKeeper(cdc, keys[account.StoreKey])
+	app.accountKeeper = account.NewAccountKeeper(cdc, keys[account.StoreKey], app.subspaces[account.ModuleName])
 	app.assetKeeper = asset.NewAssetKeeper(cdc, keys[asset.StoreKey], app.accountKeeper)
 	app.supplyKeeper = supply.NewKeeper(
 		cdc, keys[supply.StoreKey], app.accountKeeper, app.assetKeeper, maccPerms,
@@ -199,9 +199,9 @@ func NewSimApp(
 	evidenceKeeper := evidence.NewKeeper(
 		keys[evidence.StoreKey], app.subspaces[evidence.ModuleName], &stakingKeeper, app.slashingKeeper,
 	)
-	evidenceRouter := evidence.NewRouter()
-
-	app.evidenceKeeper = *evidenceKeeper
+	evidenceRouter := evidence.NewRouter().
+		AddRoute(evidence.RouteLightClientAttack, evidence.LightClientAttackHandler(*evidenceKeeper)).
+		AddRoute(evidence.RouteInvalidPluginReport, evidence.InvalidPluginReportHandler(*evidenceKeeper))
 
 	// register the proposal types
 	govRouter := gov.NewRouter()
@@ -218,8 +218,9 @@ func NewSimApp(
 		staking.NewMultiStakingHooks(app.distrKeeper.Hooks(), app.slashingKeeper.Hooks()),
 	)
 
-	// TODO: register evidence routes
 	evidenceKeeper.SetRouter(evidenceRouter)
+	app.evidenceKeeper = *evidenceKeeper
+
 	app.mintKeeper = mint.NewKeeper(
 		cdc, keys[mint.StoreKey], app.subspaces[mint.ModuleName], &app.stakingKeeper,
 		app.supplyKeeper, constants.FeeSystemAccountStr,
@@ -228,7 +229,7 @@ func NewSimApp(
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
 	app.mm = module.NewManager(
-		account.NewAppModule(app.accountKeeper, app.assetKeeper),
+		account.NewAppModule(app.accountKeeper, app.assetKeeper, app.stakingKeeper, app.distrKeeper),
 		genutil.NewAppModule(app.accountKeeper, app.stakingKeeper, app.BaseApp.DeliverTx, app.stakingFuncManager),
 		asset.NewAppModule(app.accountKeeper, app.assetKeeper),
 		supply.NewAppModule(app.supplyKeeper, app.assetKeeper, app.accountKeeper),
@@ -242,7 +243,7 @@ func NewSimApp(
 	)
 
 	// plugin.ModuleName MUST be the last
-	app.mm.SetOrderBeginBlockers(mint.ModuleName, distr.ModuleName, slashing.ModuleName, evidence.ModuleName, plugin.ModuleName)
+	app.mm.SetOrderBeginBlockers(account.ModuleName, mint.ModuleName, distr.ModuleName, staking.ModuleName, slashing.ModuleName, evidence.ModuleName, plugin.ModuleName)
 	app.mm.SetOrderEndBlockers(staking.ModuleName, gov.ModuleName, plugin.ModuleName)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -265,7 +266,7 @@ func NewSimApp(
 	// NOTE: This is not required for apps that don't use the simulator for fuzz testing
 	// transactions.
 	app.sm = module.NewSimulationManager(
-		account.NewAppModule(app.accountKeeper, app.assetKeeper),
+		account.NewAppModule(app.accountKeeper, app.assetKeeper, app.stakingKeeper, app.distrKeeper),
 		supply.NewAppModule(app.supplyKeeper, app.assetKeeper, app.accountKeeper),
 		distr.NewAppModule(app.distrKeeper, app.accountKeeper, app.assetKeeper, app.supplyKeeper, app.stakingKeeper),
 		staking.NewAppModule(app.stakingKeeper, app.accountKeeper, app.assetKeeper, app.supplyKeeper),
@@ -411,10 +412,24 @@ func (app *SimApp) SlashKeeper() *slashing.Keeper {
 	return &app.slashingKeeper
 }
 
+func (app *SimApp) EvidenceKeeper() *evidence.Keeper {
+	return &app.evidenceKeeper
+}
+
+// DistrKeeper get distribution keeper
+func (app *SimApp) DistrKeeper() *distr.Keeper {
+	return &app.distrKeeper
+}
+
 func (app *SimApp) GovKeeper() *gov.Keeper {
 	return &app.govKeeper
 }
 
+// ParamsKeeper get params keeper
+func (app *SimApp) ParamsKeeper() *params.Keeper {
+	return &app.paramsKeeper
+}
+
 // GetMaccPerms returns a copy of the module account permissions
 func GetMaccPerms() map[string][]string {
 	dupMaccPerms := make(map[string][]string)
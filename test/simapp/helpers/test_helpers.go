@@ -33,7 +33,7 @@ func GenTx(msgs []sdk.Msg, feeAmt types.Coins, gas uint64, payer types.AccountID
 
 	for i, p := range priv {
 		// use a empty chainID for ease of testing
-		sig, err := p.Sign(types.StdSignBytes(chainID, accNums[i], seq[i], fee, msgs, memo))
+		sig, err := p.Sign(types.StdSignBytes(chainID, accNums[i], seq[i], fee, msgs, memo, types.TxOptions{}))
 		if err != nil {
 			panic(err)
 		}
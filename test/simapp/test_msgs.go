@@ -31,7 +31,7 @@ func CommitTransferTx(t *testing.T, app *SimApp, wallet *Wallet, isSuccess bool,
 func NewTestTx(ctx sdk.Context, msgs []sdk.Msg, privs []crypto.PrivKey, accNums []uint64, seqs []uint64, fee types.StdFee) sdk.Tx {
 	sigs := make([]types.StdSignature, len(privs))
 	for i, priv := range privs {
-		signBytes := types.StdSignBytes(ctx.ChainID(), accNums[i], seqs[i], fee, msgs, "")
+		signBytes := types.StdSignBytes(ctx.ChainID(), accNums[i], seqs[i], fee, msgs, "", types.TxOptions{})
 
 		sig, err := priv.Sign(signBytes)
 		if err != nil {
@@ -48,7 +48,7 @@ func NewTestTx(ctx sdk.Context, msgs []sdk.Msg, privs []crypto.PrivKey, accNums
 func NewTestTxWithMemo(ctx sdk.Context, msgs []sdk.Msg, privs []crypto.PrivKey, accNums []uint64, seqs []uint64, fee types.StdFee, memo string) sdk.Tx {
 	sigs := make([]types.StdSignature, len(privs))
 	for i, priv := range privs {
-		signBytes := types.StdSignBytes(ctx.ChainID(), accNums[i], seqs[i], fee, msgs, memo)
+		signBytes := types.StdSignBytes(ctx.ChainID(), accNums[i], seqs[i], fee, msgs, memo, types.TxOptions{})
 
 		sig, err := priv.Sign(signBytes)
 		if err != nil {
@@ -16,6 +16,7 @@ const (
 type (
 	Keeper       = keeper.AccountKeeper
 	GenesisState = types.GenesisState
+	Params       = types.Params
 )
 
 var (
@@ -24,5 +25,7 @@ var (
 	NewKuAccount        = types.NewKuAccount
 	DefaultGenesisState = types.DefaultGenesisState
 	NewGenesisState     = types.NewGenesisState
+	DefaultParams       = types.DefaultParams
+	NewParams           = types.NewParams
 	ModuleCdc           = types.ModuleCdc
 )
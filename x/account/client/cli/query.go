@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/KuChainNetwork/kuchain/chain/client/flags"
 	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
@@ -10,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 // GetQueryCmd returns the transaction commands for this module
@@ -26,6 +28,11 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 		GetAccountCmd(cdc),
 		GetAuthCmd(cdc),
 		GetAccountsCmd(cdc),
+		GetRecordsCmd(cdc),
+		GetPrimaryNameCmd(cdc),
+		GetAccountListCmd(cdc),
+		GetAccountsByCreatorCmd(cdc),
+		GetAccountsByHeightCmd(cdc),
 	)
 
 	return cmd
@@ -97,6 +104,7 @@ func GetAccountsCmd(cdc *codec.Codec) *cobra.Command {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
 			params := types.NewQueryAccountsByAuthParams(args[0])
+			params.Page, params.Limit = viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit)
 			bz, err := cdc.MarshalJSON(params)
 			if err != nil {
 				return fmt.Errorf("failed to marshal params: %w", err)
@@ -117,5 +125,152 @@ func GetAccountsCmd(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Int(flags.FlagPage, 0, "pagination page of accounts to query for, all accounts if 0")
+	cmd.Flags().Int(flags.FlagLimit, 0, "pagination limit of accounts to query for")
+
+	return flags.GetCommands(cmd)[0]
+}
+
+// GetRecordsCmd returns a query of the text records and external chain addresses of account
+func GetRecordsCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "records [name]",
+		Short: "Query the text records and external chain addresses of account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			id, err := chainTypes.NewAccountIDFromStr(args[0])
+			if err != nil {
+				return err
+			}
+
+			records, _, err := types.NewAccountRetriever(cliCtx).GetNameRecordsWithHeight(id)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(records)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
+// GetPrimaryNameCmd returns a query of the primary name of address
+func GetPrimaryNameCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "primary [acc-address]",
+		Short: "Query the primary name of address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := chainTypes.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			primary, _, err := types.NewAccountRetriever(cliCtx).GetPrimaryNameWithHeight(addr)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(primary)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
+// GetAccountListCmd returns a query of all accounts by page
+func GetAccountListCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Query all accounts by page",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			accounts, _, err := types.NewAccountRetriever(cliCtx).GetAccountsWithHeight(
+				viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit))
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(accounts)
+		},
+	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of accounts to query for")
+	cmd.Flags().Int(flags.FlagLimit, types.DefaultQueryLimit, "pagination limit of accounts to query for")
+
+	return flags.GetCommands(cmd)[0]
+}
+
+// GetAccountsByCreatorCmd returns a query of the accounts created by creator
+func GetAccountsByCreatorCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "created-by [creator]",
+		Short: "Query the accounts created by creator",
+		Long:  "Query the accounts created by creator, the accounts in genesis or created before the creation index are not listed",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			creator, err := chainTypes.NewAccountIDFromStr(args[0])
+			if err != nil {
+				return err
+			}
+
+			accounts, _, err := types.NewAccountRetriever(cliCtx).GetAccountsByCreatorWithHeight(
+				creator, viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit))
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(accounts)
+		},
+	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of accounts to query for")
+	cmd.Flags().Int(flags.FlagLimit, types.DefaultQueryLimit, "pagination limit of accounts to query for")
+
+	return flags.GetCommands(cmd)[0]
+}
+
+// GetAccountsByHeightCmd returns a query of the accounts created in a height range
+func GetAccountsByHeightCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "created-in [min-height] [max-height]",
+		Short: "Query the accounts created in the height range, both ends included",
+		Long:  "Query the accounts created in the height range, both ends included, the accounts in genesis or created before the creation index are not listed",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			minHeight, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			maxHeight, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			accounts, _, err := types.NewAccountRetriever(cliCtx).GetAccountsByHeightWithHeight(
+				minHeight, maxHeight, viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit))
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(accounts)
+		},
+	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of accounts to query for")
+	cmd.Flags().Int(flags.FlagLimit, types.DefaultQueryLimit, "pagination limit of accounts to query for")
+
 	return flags.GetCommands(cmd)[0]
 }
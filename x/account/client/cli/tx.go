@@ -13,6 +13,11 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	flagReleaseName = "release-name"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -28,6 +33,10 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	txCmd.AddCommand(
 		CreateAccount(cdc),
 		UpdateAccountAuth(cdc),
+		SetTextRecord(cdc),
+		SetAddressRecord(cdc),
+		SetPrimaryName(cdc),
+		CloseAccount(cdc),
 	)
 
 	return txCmd
@@ -112,3 +121,146 @@ func UpdateAccountAuth(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// SetTextRecord will set a text record of account
+func SetTextRecord(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "settext [account_name] [key] [value]",
+		Short: "set a text record of account, an empty value deletes the record",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			accountName, err := chainTypes.NewName(args[0])
+			if err != nil {
+				return err
+			}
+
+			id := chainTypes.NewAccountIDFromName(accountName)
+
+			ctx := txutil.NewKuCLICtx(cliCtx).WithFromAccount(id)
+			auth, err := txutil.QueryAccountAuth(ctx, id)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "query account %s auth error", id)
+			}
+
+			msg := types.NewMsgSetTextRecord(auth, accountName, args[1], args[2])
+			return txutil.GenerateOrBroadcastMsgs(ctx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd = flags.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+// SetAddressRecord will link a address in external chain to account
+func SetAddressRecord(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "setaddress [account_name] [chain] [address]",
+		Short: "link a address in external chain to account, an empty address deletes the record",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			accountName, err := chainTypes.NewName(args[0])
+			if err != nil {
+				return err
+			}
+
+			id := chainTypes.NewAccountIDFromName(accountName)
+
+			ctx := txutil.NewKuCLICtx(cliCtx).WithFromAccount(id)
+			auth, err := txutil.QueryAccountAuth(ctx, id)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "query account %s auth error", id)
+			}
+
+			msg := types.NewMsgSetAddressRecord(auth, accountName, args[1], args[2])
+			return txutil.GenerateOrBroadcastMsgs(ctx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd = flags.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+// SetPrimaryName will set the primary name of a address
+func SetPrimaryName(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "setprimary [acc-address] [account_name]",
+		Short: "set the primary name of address to a account controlled by it, an empty name clears it",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			addr, err := chainTypes.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			accountName, err := chainTypes.NewName(args[1])
+			if err != nil {
+				return err
+			}
+
+			ctx := txutil.NewKuCLICtx(cliCtx).WithFromAccount(chainTypes.NewAccountIDFromAccAdd(addr))
+
+			msg := types.NewMsgSetPrimaryName(addr, accountName)
+			return txutil.GenerateOrBroadcastMsgs(ctx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd = flags.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+// CloseAccount will close a account and sweep the remaining coins to beneficiary
+func CloseAccount(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "close [account_name] [beneficiary]",
+		Short: "close a account without locked, power or staking coins, the remaining coins are sweep to beneficiary",
+		Long: `Close a account, the remaining coins are sweep to beneficiary. The name of account is reserved forever,
+unless --release-name is set, then it can be registered again after the cooldown in params.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			accountName, err := chainTypes.NewName(args[0])
+			if err != nil {
+				return err
+			}
+
+			beneficiary, err := chainTypes.NewAccountIDFromStr(args[1])
+			if err != nil {
+				return err
+			}
+
+			id := chainTypes.NewAccountIDFromName(accountName)
+
+			ctx := txutil.NewKuCLICtx(cliCtx).WithFromAccount(id)
+			auth, err := txutil.QueryAccountAuth(ctx, id)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "query account %s auth error", id)
+			}
+
+			msg := types.NewMsgCloseAccount(auth, accountName, beneficiary, viper.GetBool(flagReleaseName))
+			return txutil.GenerateOrBroadcastMsgs(ctx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Bool(flagReleaseName, false, "release the name to be registered again after the cooldown")
+	cmd = flags.PostCommands(cmd)[0]
+
+	return cmd
+}
@@ -11,6 +11,11 @@ import (
 )
 
 func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	// before the routes of auth and primary, as a account may be named "auth" or "primary"
+	r.HandleFunc(
+		"/account/{name}/records",
+		getNameRecordsHandlerFn(cliCtx),
+	).Methods("GET")
 	r.HandleFunc(
 		"/account/{name}",
 		getAccountHandlerFn(cliCtx),
@@ -19,10 +24,26 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		"/account/auth/{auth}",
 		getAuthHandlerFn(cliCtx),
 	).Methods("GET")
+	r.HandleFunc(
+		"/accounts",
+		getAccountListHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/accounts/creator/{creator}",
+		getAccountsByCreatorHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/accounts/height/{min}/{max}",
+		getAccountsByHeightHandlerFn(cliCtx),
+	).Methods("GET")
 	r.HandleFunc(
 		"/accounts/{auth}",
 		getAccountsByAuthHandlerFn(cliCtx),
 	).Methods("GET")
+	r.HandleFunc(
+		"/account/primary/{address}",
+		getPrimaryNameHandlerFn(cliCtx),
+	).Methods("GET")
 }
 
 func getAccountHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
@@ -94,6 +115,17 @@ func getAccountsByAuthHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		}
 
 		params := types.NewQueryAccountsByAuthParams(auth)
+
+		// all accounts returned if no page for compatibility
+		if r.FormValue("page") != "" || r.FormValue("limit") != "" {
+			_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, types.DefaultQueryLimit)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			params.Page, params.Limit = page, limit
+		}
+
 		bz, err := cliCtx.Codec.MarshalJSON(params)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
@@ -117,3 +149,147 @@ func getAccountsByAuthHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx, result)
 	}
 }
+
+func getNameRecordsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		id, err := chainTypes.NewAccountIDFromStr(vars["name"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		records, height, err := types.NewAccountRetriever(cliCtx).GetNameRecordsWithHeight(id)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, records)
+	}
+}
+
+func getPrimaryNameHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		addr, err := chainTypes.AccAddressFromBech32(vars["address"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("new acc-address error %v", err.Error()))
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		primary, height, err := types.NewAccountRetriever(cliCtx).GetPrimaryNameWithHeight(addr)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, primary)
+	}
+}
+
+func getAccountListHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, types.DefaultQueryLimit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		accounts, height, err := types.NewAccountRetriever(cliCtx).GetAccountsWithHeight(page, limit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, accounts)
+	}
+}
+
+func getAccountsByCreatorHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		creator, err := chainTypes.NewAccountIDFromStr(vars["creator"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, types.DefaultQueryLimit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		accounts, height, err := types.NewAccountRetriever(cliCtx).GetAccountsByCreatorWithHeight(creator, page, limit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, accounts)
+	}
+}
+
+func getAccountsByHeightHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		minHeight, ok := rest.ParseInt64OrReturnBadRequest(w, vars["min"])
+		if !ok {
+			return
+		}
+
+		maxHeight, ok := rest.ParseInt64OrReturnBadRequest(w, vars["max"])
+		if !ok {
+			return
+		}
+
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, types.DefaultQueryLimit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		accounts, height, err := types.NewAccountRetriever(cliCtx).GetAccountsByHeightWithHeight(minHeight, maxHeight, page, limit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, accounts)
+	}
+}
@@ -25,6 +25,33 @@ type UpdateAuthReq struct {
 	NewAccountAuth string       `json:"new_account_auth" yaml:"new_account_auth"`
 }
 
+type SetTextRecordReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Account string       `json:"account" yaml:"account"`
+	Key     string       `json:"key" yaml:"key"`
+	Value   string       `json:"value" yaml:"value"`
+}
+
+type SetAddressRecordReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Account string       `json:"account" yaml:"account"`
+	Chain   string       `json:"chain" yaml:"chain"`
+	Address string       `json:"address" yaml:"address"`
+}
+
+type SetPrimaryNameReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Address string       `json:"address" yaml:"address"`
+	Account string       `json:"account" yaml:"account"`
+}
+
+type CloseAccountReq struct {
+	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Account     string       `json:"account" yaml:"account"`
+	Beneficiary string       `json:"beneficiary" yaml:"beneficiary"`
+	ReleaseName bool         `json:"release_name" yaml:"release_name"`
+}
+
 func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc(
 		"/account/create",
@@ -34,6 +61,22 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		"/account/update_auth",
 		updateAuthHandlerFn(cliCtx),
 	).Methods("POST")
+	r.HandleFunc(
+		"/account/set_text",
+		setTextRecordHandlerFn(cliCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		"/account/set_address",
+		setAddressRecordHandlerFn(cliCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		"/account/set_primary",
+		setPrimaryNameHandlerFn(cliCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		"/account/close",
+		closeAccountHandlerFn(cliCtx),
+	).Methods("POST")
 }
 
 func createAccountHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
@@ -127,3 +170,160 @@ func updateAuthHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		txutil.WriteGenerateStdTxResponse(w, ctx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+func setTextRecordHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SetTextRecordReq
+
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		err = cliCtx.Codec.UnmarshalJSON(body, &req)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+
+		accountName, err := chainTypes.NewName(req.Account)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		account := chainTypes.NewAccountIDFromName(accountName)
+
+		ctx := txutil.NewKuCLICtx(cliCtx).WithFromAccount(account)
+		auth, err := txutil.QueryAccountAuth(ctx, account)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgSetTextRecord(auth, accountName, req.Key, req.Value)
+		txutil.WriteGenerateStdTxResponse(w, ctx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func setAddressRecordHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SetAddressRecordReq
+
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		err = cliCtx.Codec.UnmarshalJSON(body, &req)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+
+		accountName, err := chainTypes.NewName(req.Account)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		account := chainTypes.NewAccountIDFromName(accountName)
+
+		ctx := txutil.NewKuCLICtx(cliCtx).WithFromAccount(account)
+		auth, err := txutil.QueryAccountAuth(ctx, account)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgSetAddressRecord(auth, accountName, req.Chain, req.Address)
+		txutil.WriteGenerateStdTxResponse(w, ctx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func setPrimaryNameHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SetPrimaryNameReq
+
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		err = cliCtx.Codec.UnmarshalJSON(body, &req)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+
+		address, err := sdk.AccAddressFromBech32(req.Address)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		accountName, err := chainTypes.NewName(req.Account)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		ctx := txutil.NewKuCLICtx(cliCtx).WithFromAccount(chainTypes.NewAccountIDFromAccAdd(address))
+
+		msg := types.NewMsgSetPrimaryName(address, accountName)
+		txutil.WriteGenerateStdTxResponse(w, ctx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func closeAccountHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CloseAccountReq
+
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		err = cliCtx.Codec.UnmarshalJSON(body, &req)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+
+		accountName, err := chainTypes.NewName(req.Account)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		beneficiary, err := chainTypes.NewAccountIDFromStr(req.Beneficiary)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		account := chainTypes.NewAccountIDFromName(accountName)
+
+		ctx := txutil.NewKuCLICtx(cliCtx).WithFromAccount(account)
+		auth, err := txutil.QueryAccountAuth(ctx, account)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgCloseAccount(auth, accountName, beneficiary, req.ReleaseName)
+		txutil.WriteGenerateStdTxResponse(w, ctx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -0,0 +1,127 @@
+package account_test
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	accountTypes "github.com/KuChainNetwork/kuchain/x/account/types"
+	assetTypes "github.com/KuChainNetwork/kuchain/x/asset/types"
+	distrTypes "github.com/KuChainNetwork/kuchain/x/distribution/types"
+)
+
+func TestCloseAccount(t *testing.T) {
+	name3 := types.MustName("cccdddeeefff")
+	account3 := types.NewAccountIDFromName(name3)
+
+	assets := types.NewInt64Coins(constants.DefaultBondDenom, 10000000000)
+	genAccs := simapp.NewGenesisAccounts(
+		wallet.GetRootAuth(),
+		simapp.NewSimGenesisAccount(account1, addr1).WithAsset(assets),
+		simapp.NewSimGenesisAccount(account2, addr2).WithAsset(assets),
+		simapp.NewSimGenesisAccount(account3, addr3).WithAsset(assets))
+	app := simapp.SetupWithGenesisAccounts(genAccs)
+
+	getCoins := func(id types.AccountID) types.Coins {
+		ctx := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
+		coins, err := app.AssetKeeper().GetCoins(ctx, id)
+		So(err, ShouldBeNil)
+		return coins
+	}
+
+	Convey("cannot close account with locked coins", t, func() {
+		lock := assetTypes.NewMsgLockCoin(addr3, account3,
+			types.NewInt64Coins(constants.DefaultBondDenom, 100), app.LastBlockHeight()+10000)
+		_, err := deliverAccountMsg(t, app, true, account3, addr3, &lock)
+		So(err, ShouldBeNil)
+
+		closeMsg := accountTypes.NewMsgCloseAccount(addr3, name3, account2, true)
+		_, err = deliverAccountMsg(t, app, false, account3, addr3, &closeMsg)
+		So(err, simapp.ShouldErrIs, accountTypes.ErrAccountCannotClose)
+	})
+
+	Convey("close account requires the auth of account", t, func() {
+		closeMsg := accountTypes.NewMsgCloseAccount(addr2, name1, account2, true)
+		_, err := deliverAccountMsg(t, app, false, account2, addr2, &closeMsg)
+		So(err, simapp.ShouldErrIs, types.ErrMissingAuth)
+	})
+
+	Convey("close account sweeps coins and releases the name", t, func() {
+		header := abci.Header{Height: app.LastBlockHeight() + 1}
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+		for _, isCheckTx := range []bool{true, false} {
+			app.AccountKeeper().SetParams(app.BaseApp.NewContext(isCheckTx, header),
+				accountTypes.NewParams(false, accountTypes.DefaultRecordGasPerByte, 0, accountTypes.DefaultMsgGasSchedule()))
+		}
+
+		setURL := accountTypes.NewMsgSetTextRecord(addr1, name1, "url", "https://kuchain.io")
+		_, err := deliverAccountMsg(t, app, true, account1, addr1, &setURL)
+		So(err, ShouldBeNil)
+
+		// account3 withdraws its rewards to account1, and account1 to account2
+		setWithdraw := distrTypes.NewMsgSetWithdrawAccountId(addr3, account3, account1)
+		_, err = deliverAccountMsg(t, app, true, account3, addr3, setWithdraw)
+		So(err, ShouldBeNil)
+
+		setWithdraw = distrTypes.NewMsgSetWithdrawAccountId(addr1, account1, account2)
+		_, err = deliverAccountMsg(t, app, true, account1, addr1, setWithdraw)
+		So(err, ShouldBeNil)
+
+		// the fee is paid by account1 before the coins swept
+		remaining := getCoins(account1)
+		beneficiaryCoins := getCoins(account2)
+		fee := types.NewInt64Coins(constants.DefaultBondDenom, 100000)
+
+		closeMsg := accountTypes.NewMsgCloseAccount(addr1, name1, account2, true)
+		_, err = deliverAccountMsg(t, app, true, account1, addr1, &closeMsg)
+		So(err, ShouldBeNil)
+
+		So(getCoins(account1).IsZero(), ShouldBeTrue)
+		So(getCoins(account2), ShouldResemble, beneficiaryCoins.Add(remaining.Sub(fee)...))
+
+		ctx := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
+		So(app.AccountKeeper().GetAccount(ctx, account1), ShouldBeNil)
+		So(app.AccountKeeper().GetAccountsByAuth(ctx, addr1), ShouldBeEmpty)
+		So(app.AccountKeeper().GetNameRecords(ctx, account1).Empty(), ShouldBeTrue)
+
+		_, found := app.AccountKeeper().GetAccountCreation(ctx, name1)
+		So(found, ShouldBeFalse)
+
+		// the withdraw addresses referencing the closed account are cleared
+		So(app.DistrKeeper().GetDelegatorWithdrawAddr(ctx, account3), ShouldResemble, account3)
+		So(app.DistrKeeper().GetDelegatorWithdrawAddr(ctx, account1), ShouldResemble, account1)
+
+		closed, ok := app.AccountKeeper().GetClosedName(ctx, name1)
+		So(ok, ShouldBeTrue)
+		So(closed.Released, ShouldBeTrue)
+
+		// coins cannot be sent to closed account
+		transfer := assetTypes.NewMsgTransfer(addr2, account2, account1, types.NewInt64Coins(constants.DefaultBondDenom, 1))
+		_, err = deliverAccountMsg(t, app, false, account2, addr2, &transfer)
+		So(err, simapp.ShouldErrIs, accountTypes.ErrAccountNoFound)
+
+		// the released name can be registered again
+		So(testAccountCreate(t, app, wallet, true, account2, name1, addr2), ShouldBeNil)
+
+		ctx = app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
+		So(app.AccountKeeper().GetAccount(ctx, account1).GetAuth().Equals(addr2), ShouldBeTrue)
+		_, ok = app.AccountKeeper().GetClosedName(ctx, name1)
+		So(ok, ShouldBeFalse)
+
+		// the new owner of the name does not receive the rewards of account3
+		So(app.DistrKeeper().GetDelegatorWithdrawAddr(ctx, account3), ShouldResemble, account3)
+	})
+
+	Convey("name is reserved if not released", t, func() {
+		closeMsg := accountTypes.NewMsgCloseAccount(addr2, name1, account2, false)
+		_, err := deliverAccountMsg(t, app, true, account2, addr2, &closeMsg)
+		So(err, ShouldBeNil)
+
+		err = testAccountCreate(t, app, wallet, false, account2, name1, addr2)
+		So(err, simapp.ShouldErrIs, accountTypes.ErrAccountNameClosed)
+	})
+}
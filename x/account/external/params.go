@@ -0,0 +1,14 @@
+package external
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/params/types"
+)
+
+type ParamsKeyTable = types.KeyTable
+type ParamsSubspace = types.Subspace
+type ParamSet = types.ParamSet
+
+var ParamNewKeyTable = types.NewKeyTable
+var ParamNewParamSetPair = types.NewParamSetPair
+
+type ParamSetPairs = types.ParamSetPairs
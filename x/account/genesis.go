@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	"github.com/KuChainNetwork/kuchain/x/account/exported"
+	"github.com/KuChainNetwork/kuchain/x/account/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -14,6 +15,8 @@ func InitGenesis(ctx sdk.Context, ak Keeper, data json.RawMessage) {
 	var genesisState GenesisState
 	ModuleCdc.MustUnmarshalJSON(data, &genesisState)
 
+	ak.SetParams(ctx, genesisState.Params)
+
 	for _, a := range genesisState.Accounts {
 		logger.Info("init genesis account", "name", a.GetName(), "auth", a.GetAuth())
 		ak.SetAccount(ctx, ak.NewAccount(ctx, a))
@@ -24,6 +27,30 @@ func InitGenesis(ctx sdk.Context, ak Keeper, data json.RawMessage) {
 			ak.AddAccountByAuth(ctx, a.GetAuth(), a.GetName().String())
 		}
 	}
+
+	for _, records := range genesisState.NameRecords {
+		if err := records.Validate(); err != nil {
+			panic(err)
+		}
+		ak.SetNameRecords(ctx, records)
+	}
+
+	for _, primary := range genesisState.PrimaryNames {
+		ak.SetPrimaryName(ctx, primary.Address, primary.Name)
+	}
+
+	for _, closed := range genesisState.ClosedNames {
+		ak.SetClosedName(ctx, closed)
+	}
+
+	// the creations are only restored from the exported genesis, which keeps the creator and height,
+	// the accounts created in genesis or before the creation indexes are not listed by creator and height
+	for _, creation := range genesisState.AccountCreations {
+		if creation.Creator.Empty() {
+			continue
+		}
+		ak.SetAccountCreation(ctx, creation)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper
@@ -34,7 +61,37 @@ func ExportGenesis(ctx sdk.Context, ak Keeper) GenesisState {
 		return false
 	})
 
+	var nameRecords []types.NameRecords
+	ak.IterateNameRecords(ctx, func(records types.NameRecords) bool {
+		nameRecords = append(nameRecords, records)
+		return false
+	})
+
+	var primaryNames []types.PrimaryName
+	ak.IteratePrimaryNames(ctx, func(primary types.PrimaryName) bool {
+		primaryNames = append(primaryNames, primary)
+		return false
+	})
+
+	var closedNames []types.ClosedName
+	ak.IterateClosedNames(ctx, func(closed types.ClosedName) bool {
+		closedNames = append(closedNames, closed)
+		return false
+	})
+
+	var creations []types.AccountCreation
+	ak.IterateAccountCreations(ctx, func(creation types.AccountCreation) bool {
+		creations = append(creations, creation)
+		return false
+	})
+
 	return GenesisState{
-		Accounts: genAccounts,
+		Params:       ak.GetParams(ctx),
+		Accounts:     genAccounts,
+		NameRecords:  nameRecords,
+		PrimaryNames: primaryNames,
+		ClosedNames:  closedNames,
+
+		AccountCreations: creations,
 	}
 }
@@ -10,13 +10,22 @@ import (
 )
 
 // NewHandler returns a handler for "bank" type messages.
-func NewHandler(k Keeper) msg.Handler {
+func NewHandler(k Keeper, assetKeeper types.AssetKeeper, stakingKeeper types.StakingKeeper,
+	distrKeeper types.DistributionKeeper) msg.Handler {
 	return func(ctx chainTypes.Context, msg sdk.Msg) (*sdk.Result, error) {
 		switch msg := msg.(type) {
 		case *types.MsgCreateAccount:
 			return handleMsgCreateAccount(ctx, k, msg)
 		case *types.MsgUpdateAccountAuth:
 			return handleMsgUpdateAccountAuth(ctx, k, msg)
+		case *types.MsgSetTextRecord:
+			return handleMsgSetTextRecord(ctx, k, msg)
+		case *types.MsgSetAddressRecord:
+			return handleMsgSetAddressRecord(ctx, k, msg)
+		case *types.MsgSetPrimaryName:
+			return handleMsgSetPrimaryName(ctx, k, msg)
+		case *types.MsgCloseAccount:
+			return handleMsgCloseAccount(ctx, k, assetKeeper, stakingKeeper, distrKeeper, msg)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized account message type: %T", msg)
 		}
@@ -38,11 +47,16 @@ func handleMsgCreateAccount(ctx chainTypes.Context, k Keeper, msg *types.MsgCrea
 		return nil, types.ErrAccountCannotCreateSysAccount
 	}
 
-	// user only can create 12-length account
+	isNameV2 := msgData.Name.Version() == chainTypes.NameVersionV2
+	if isNameV2 && !k.NameV2Enabled(ctx.Context()) {
+		return nil, sdkerrors.Wrapf(types.ErrAccountNameV2Disabled, "name %s", msgData.Name)
+	}
+
+	// user only can create 12-length account, or the names in version 2 not shorter than 12
 	if creator, ok := msgData.Creator.ToName(); ok && constants.IsSystemAccount(creator) {
 		// system account can create accounts
 	} else {
-		if msgData.Name.Len() != 12 {
+		if (!isNameV2 && msgData.Name.Len() != 12) || (isNameV2 && msgData.Name.Len() < 12) {
 			return nil, types.ErrAccountNameLenInvalid
 		}
 
@@ -59,6 +73,15 @@ func handleMsgCreateAccount(ctx chainTypes.Context, k Keeper, msg *types.MsgCrea
 		return nil, sdkerrors.Wrapf(types.ErrAccountHasCreated, "name %s", msgData.Name)
 	}
 
+	// the name of closed account can be registered again only after released
+	if closed, ok := k.GetClosedName(ctx.Context(), msgData.Name); ok {
+		if !closed.CanRegister(ctx.Context().BlockTime()) {
+			return nil, sdkerrors.Wrapf(types.ErrAccountNameClosed, "%s", closed)
+		}
+
+		k.DeleteClosedName(ctx.Context(), msgData.Name)
+	}
+
 	newAccount := k.NewAccountByName(ctx.Context(), msgData.Name)
 	if err := newAccount.SetAuth(msgData.Auth); err != nil {
 		return nil, sdkerrors.Wrapf(err, "set auth to account error")
@@ -70,6 +93,7 @@ func handleMsgCreateAccount(ctx chainTypes.Context, k Keeper, msg *types.MsgCrea
 	// add auth
 	k.EnsureAuthInited(ctx.Context(), msgData.Auth)
 	k.AddAccountByAuth(ctx.Context(), msgData.Auth, newAccount.GetName().String())
+	k.SetAccountCreation(ctx.Context(), types.NewAccountCreation(msgData.Name, msgData.Creator, ctx.Context().BlockHeight()))
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -115,6 +139,7 @@ func handleMsgUpdateAccountAuth(ctx chainTypes.Context, k Keeper, msg *types.Msg
 	k.EnsureAuthInited(ctx.Context(), msgData.Auth)
 	k.AddAccountByAuth(ctx.Context(), msgData.Auth, accountStat.GetName().String())
 	k.DeleteAccountByAuth(ctx.Context(), oldAuth, accountStat.GetName().String())
+	k.DeletePrimaryNameIf(ctx.Context(), oldAuth, accountStat.GetName())
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -126,3 +151,198 @@ func handleMsgUpdateAccountAuth(ctx chainTypes.Context, k Keeper, msg *types.Msg
 
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
+
+// consumeRecordGas consumes gas proportional to the size of the record set
+func consumeRecordGas(ctx chainTypes.Context, k Keeper, size int) {
+	ctx.Context().GasMeter().ConsumeGas(k.RecordGasPerByte(ctx.Context())*uint64(size), "name record")
+}
+
+// handleMsgSetTextRecord handler msg set text record
+func handleMsgSetTextRecord(ctx chainTypes.Context, k Keeper, msg *types.MsgSetTextRecord) (*sdk.Result, error) {
+	msgData, err := msg.GetData()
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg set text record data unmarshal error")
+	}
+
+	ctx.Logger().Debug("msg set text record", "name", msgData.Name, "key", msgData.Key)
+
+	if a := k.GetAccountByName(ctx.Context(), msgData.Name); a == nil {
+		return nil, sdkerrors.Wrapf(types.ErrAccountNoFound, "name %s", msgData.Name)
+	}
+
+	ctx.RequireAccount(msgData.Name)
+	consumeRecordGas(ctx, k, len(msgData.Key)+len(msgData.Value))
+
+	records := k.GetNameRecords(ctx.Context(), chainTypes.NewAccountIDFromName(msgData.Name))
+	if err := records.SetText(msgData.Key, msgData.Value); err != nil {
+		return nil, sdkerrors.Wrapf(err, "set text record %s", msgData.Key)
+	}
+
+	k.SetNameRecords(ctx.Context(), records)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetTextRecord,
+			sdk.NewAttribute(types.AttributeKeyAccount, msgData.Name.String()),
+			sdk.NewAttribute(types.AttributeKeyKey, msgData.Key),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// handleMsgSetAddressRecord handler msg set address record
+func handleMsgSetAddressRecord(ctx chainTypes.Context, k Keeper, msg *types.MsgSetAddressRecord) (*sdk.Result, error) {
+	msgData, err := msg.GetData()
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg set address record data unmarshal error")
+	}
+
+	ctx.Logger().Debug("msg set address record", "name", msgData.Name, "chain", msgData.Chain)
+
+	if a := k.GetAccountByName(ctx.Context(), msgData.Name); a == nil {
+		return nil, sdkerrors.Wrapf(types.ErrAccountNoFound, "name %s", msgData.Name)
+	}
+
+	ctx.RequireAccount(msgData.Name)
+	consumeRecordGas(ctx, k, len(msgData.Chain)+len(msgData.Address))
+
+	records := k.GetNameRecords(ctx.Context(), chainTypes.NewAccountIDFromName(msgData.Name))
+	if err := records.SetAddress(msgData.Chain, msgData.Address); err != nil {
+		return nil, sdkerrors.Wrapf(err, "set address record %s", msgData.Chain)
+	}
+
+	k.SetNameRecords(ctx.Context(), records)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetAddressRecord,
+			sdk.NewAttribute(types.AttributeKeyAccount, msgData.Name.String()),
+			sdk.NewAttribute(types.AttributeKeyChain, msgData.Chain),
+			sdk.NewAttribute(types.AttributeKeyAddress, msgData.Address),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// handleMsgSetPrimaryName handler msg set primary name
+func handleMsgSetPrimaryName(ctx chainTypes.Context, k Keeper, msg *types.MsgSetPrimaryName) (*sdk.Result, error) {
+	msgData, err := msg.GetData()
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg set primary name data unmarshal error")
+	}
+
+	ctx.Logger().Debug("msg set primary name", "address", msgData.Address, "name", msgData.Name)
+
+	ctx.RequireAccountAuth(msgData.Address)
+
+	if !msgData.Name.Empty() {
+		account := k.GetAccountByName(ctx.Context(), msgData.Name)
+		if account == nil {
+			return nil, sdkerrors.Wrapf(types.ErrAccountNoFound, "name %s", msgData.Name)
+		}
+
+		if !account.GetAuth().Equals(msgData.Address) {
+			return nil, sdkerrors.Wrapf(types.ErrPrimaryNameAuthMismatch, "name %s address %s", msgData.Name, msgData.Address)
+		}
+	}
+
+	consumeRecordGas(ctx, k, len(msgData.Name.Bytes()))
+	k.SetPrimaryName(ctx.Context(), msgData.Address, msgData.Name)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetPrimaryName,
+			sdk.NewAttribute(types.AttributeKeyAddress, msgData.Address.String()),
+			sdk.NewAttribute(types.AttributeKeyAccount, msgData.Name.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// handleMsgCloseAccount handler msg close account
+func handleMsgCloseAccount(ctx chainTypes.Context, k Keeper, assetKeeper types.AssetKeeper, stakingKeeper types.StakingKeeper,
+	distrKeeper types.DistributionKeeper, msg *types.MsgCloseAccount) (*sdk.Result, error) {
+	msgData, err := msg.GetData()
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg close account data unmarshal error")
+	}
+
+	ctx.Logger().Debug("msg close account", "name", msgData.Name, "beneficiary", msgData.Beneficiary)
+
+	if constants.IsSystemAccount(msgData.Name) {
+		return nil, sdkerrors.Wrapf(types.ErrAccountCannotClose, "system account %s", msgData.Name)
+	}
+
+	account := k.GetAccountByName(ctx.Context(), msgData.Name)
+	if account == nil {
+		return nil, sdkerrors.Wrapf(types.ErrAccountNoFound, "name %s", msgData.Name)
+	}
+
+	// the account will be removed, so require the auth directly instead of by the name
+	auth := account.GetAuth()
+	ctx.RequireAccountAuth(auth)
+
+	id := account.GetID()
+
+	// the name may be registered again, which should not take over the coins created by the account
+	if assetKeeper.HasCreatedCoins(ctx.Context(), msgData.Name) {
+		return nil, sdkerrors.Wrapf(types.ErrAccountCannotClose, "account %s is the creator of coins", msgData.Name)
+	}
+
+	locked, err := assetKeeper.GetLockedCoins(ctx.Context(), id)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "get locked coins of %s", msgData.Name)
+	}
+
+	if !locked.IsZero() {
+		return nil, sdkerrors.Wrapf(types.ErrAccountCannotClose, "account %s has locked coins %s", msgData.Name, locked)
+	}
+
+	if powers := assetKeeper.GetCoinPowers(ctx.Context(), id); !powers.IsZero() {
+		return nil, sdkerrors.Wrapf(types.ErrAccountCannotClose, "account %s has coin powers %s", msgData.Name, powers)
+	}
+
+	if stakingKeeper.IsAccountStaking(ctx.Context(), id) {
+		return nil, sdkerrors.Wrapf(types.ErrAccountCannotClose, "account %s is validator or has delegations", msgData.Name)
+	}
+
+	// sweep the remaining coins to beneficiary
+	coins, err := assetKeeper.GetCoins(ctx.Context(), id)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "get coins of %s", msgData.Name)
+	}
+
+	if err := assetKeeper.Transfer(ctx.Context(), id, msgData.Beneficiary, coins); err != nil {
+		return nil, sdkerrors.Wrapf(err, "sweep coins to %s", msgData.Beneficiary)
+	}
+
+	// the rewards withdrawn to the account should not go to the new owner of the name
+	distrKeeper.ClearWithdrawAddrs(ctx.Context(), id)
+
+	k.SetNameRecords(ctx.Context(), types.NewNameRecords(id))
+	k.DeletePrimaryNameIf(ctx.Context(), auth, msgData.Name)
+	k.DeleteAccountByAuth(ctx.Context(), auth, msgData.Name.String())
+	k.DeleteAccountCreation(ctx.Context(), msgData.Name)
+	k.RemoveAccount(ctx.Context(), account)
+
+	closed := types.NewClosedName(msgData.Name, msgData.ReleaseName,
+		ctx.Context().BlockTime().Add(k.NameReleaseCooldown(ctx.Context())))
+	k.SetClosedName(ctx.Context(), closed)
+
+	event := sdk.NewEvent(
+		types.EventTypeCloseAccount,
+		sdk.NewAttribute(types.AttributeKeyAccount, msgData.Name.String()),
+		sdk.NewAttribute(types.AttributeKeyBeneficiary, msgData.Beneficiary.String()),
+		sdk.NewAttribute(types.AttributeKeyAmount, coins.String()),
+	)
+	if closed.Released {
+		event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyReleaseTime, closed.ReleaseTime.String()))
+	}
+
+	ctx.EventManager().EmitEvent(event)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
@@ -152,6 +152,45 @@ func TestCreateAccountNameCheck(t *testing.T) {
 	})
 }
 
+func TestCreateAccountNameV2(t *testing.T) {
+	asset1 := types.NewInt64Coins(constants.DefaultBondDenom, 10000000000)
+	genAcc := simapp.NewSimGenesisAccount(account1, addr1).WithAsset(asset1)
+	genAccs := simapp.NewGenesisAccounts(wallet.GetRootAuth(), genAcc)
+	app := simapp.SetupWithGenesisAccounts(genAccs)
+
+	nameV2 := types.MustName("brand-dapp.vault")
+
+	Convey("name v2 is disabled by default", t, func() {
+		So(nameV2.Version(), ShouldEqual, types.NameVersionV2)
+
+		err := testAccountCreate(t, app, wallet, false, account1, nameV2, addr1)
+		So(err, simapp.ShouldErrIs, accountTypes.ErrAccountNameV2Disabled)
+	})
+
+	Convey("name v2 enabled by params", t, func() {
+		header := abci.Header{Height: app.LastBlockHeight() + 1}
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+		for _, isCheckTx := range []bool{true, false} {
+			app.AccountKeeper().SetParams(app.BaseApp.NewContext(isCheckTx, header), accountTypes.NewParams(true, accountTypes.DefaultRecordGasPerByte, accountTypes.DefaultNameReleaseCooldown, accountTypes.DefaultMsgGasSchedule()))
+		}
+
+		err1 := testAccountCreate(t, app, wallet, false, account1, types.MustName("ku-chain"), addr1)
+		So(err1, simapp.ShouldErrIs, accountTypes.ErrAccountNameLenInvalid)
+
+		err2 := testAccountCreate(t, app, wallet, false, account1, types.MustName("brand--dapp.vault"), addr1)
+		So(err2, simapp.ShouldErrIs, accountTypes.ErrAccountNameInvalid)
+
+		err3 := testAccountCreate(t, app, wallet, true, account1, nameV2, addr1)
+		So(err3, ShouldBeNil)
+
+		ctxCheck := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
+		accCreated := app.AccountKeeper().GetAccount(ctxCheck, types.NewAccountIDFromName(nameV2))
+		So(accCreated, ShouldNotBeNil)
+		So(accCreated.GetName().String(), ShouldEqual, "brand-dapp.vault")
+		So(accCreated.GetAuth().Equals(addr1), ShouldBeTrue)
+	})
+}
+
 func TestCreateAccountDoubleTimes(t *testing.T) {
 	assets := types.NewInt64Coins(constants.DefaultBondDenom, 10000000000)
 	genAccs := simapp.NewGenesisAccounts(
@@ -53,6 +53,11 @@ func (ak AccountKeeper) SetAccount(ctx sdk.Context, acc exported.Account) {
 	store.Set(types.AccountIDStoreKey(n), bz)
 }
 
+// RemoveAccount remove the account from store, used when the account is closed
+func (ak AccountKeeper) RemoveAccount(ctx sdk.Context, acc exported.Account) {
+	ctx.KVStore(ak.key).Delete(types.AccountIDStoreKey(acc.GetID()))
+}
+
 // EnsureAccount ensure account is exist, if not create a account with init data
 func (ak AccountKeeper) EnsureAccount(ctx sdk.Context, id AccountID) error {
 	if accAddress, ok := id.ToAccAddress(); ok {
@@ -60,6 +60,12 @@ func (ak AccountKeeper) DeleteAccountByAuth(ctx sdk.Context, auth chaintypes.Acc
 
 	authAccounts.DeleteAccount(acc)
 
+	// drop the entry so the index not grows with the auths without accounts
+	if len(authAccounts.GetAccounts()) == 0 {
+		store.Delete(types.AuthAccountsStoreKey(auth))
+		return
+	}
+
 	bz, err := ak.cdc.MarshalBinaryBare(authAccounts)
 	if err != nil {
 		panic(err)
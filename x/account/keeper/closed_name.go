@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/account/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetClosedName get the closed name, returns false if the name is not of a closed account
+func (ak AccountKeeper) GetClosedName(ctx sdk.Context, name Name) (types.ClosedName, bool) {
+	bz := ctx.KVStore(ak.key).Get(types.ClosedNameKey(name))
+	if bz == nil {
+		return types.ClosedName{}, false
+	}
+
+	var closed types.ClosedName
+	ak.cdc.MustUnmarshalBinaryBare(bz, &closed)
+
+	return closed, true
+}
+
+// SetClosedName set the name of closed account
+func (ak AccountKeeper) SetClosedName(ctx sdk.Context, closed types.ClosedName) {
+	ctx.KVStore(ak.key).Set(types.ClosedNameKey(closed.Name), ak.cdc.MustMarshalBinaryBare(closed))
+}
+
+// DeleteClosedName delete the closed name, used when the released name is registered again
+func (ak AccountKeeper) DeleteClosedName(ctx sdk.Context, name Name) {
+	ctx.KVStore(ak.key).Delete(types.ClosedNameKey(name))
+}
+
+// IterateClosedNames iterates over the names of all closed accounts
+func (ak AccountKeeper) IterateClosedNames(ctx sdk.Context, cb func(closed types.ClosedName) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(ak.key), types.ClosedNameKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var closed types.ClosedName
+		ak.cdc.MustUnmarshalBinaryBare(iterator.Value(), &closed)
+
+		if cb(closed) {
+			break
+		}
+	}
+}
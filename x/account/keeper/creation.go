@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/account/exported"
+	"github.com/KuChainNetwork/kuchain/x/account/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// indexValue the value of the entries in indexes, the store not accepts nil values
+var indexValue = []byte{0x01}
+
+// GetAccountCreation get the creator and height of account
+func (ak AccountKeeper) GetAccountCreation(ctx sdk.Context, name Name) (types.AccountCreation, bool) {
+	bz := ctx.KVStore(ak.key).Get(types.AccountCreationKey(name))
+	if bz == nil {
+		return types.AccountCreation{}, false
+	}
+
+	var creation types.AccountCreation
+	ak.cdc.MustUnmarshalBinaryBare(bz, &creation)
+
+	return creation, true
+}
+
+// SetAccountCreation set the creation of account and add it to the indexes by creator and height
+func (ak AccountKeeper) SetAccountCreation(ctx sdk.Context, creation types.AccountCreation) {
+	ak.DeleteAccountCreation(ctx, creation.Name)
+
+	store := ctx.KVStore(ak.key)
+
+	store.Set(types.AccountCreationKey(creation.Name), ak.cdc.MustMarshalBinaryBare(creation))
+	if !creation.Creator.Empty() {
+		store.Set(types.AccountByCreatorKey(creation.Creator, creation.Name), indexValue)
+	}
+	store.Set(types.AccountByHeightKey(creation.Height, creation.Name), indexValue)
+}
+
+// DeleteAccountCreation delete the creation of account and remove it from the indexes, used when the account closed
+func (ak AccountKeeper) DeleteAccountCreation(ctx sdk.Context, name Name) {
+	creation, ok := ak.GetAccountCreation(ctx, name)
+	if !ok {
+		return
+	}
+
+	store := ctx.KVStore(ak.key)
+
+	store.Delete(types.AccountCreationKey(name))
+	if !creation.Creator.Empty() {
+		store.Delete(types.AccountByCreatorKey(creation.Creator, name))
+	}
+	store.Delete(types.AccountByHeightKey(creation.Height, name))
+}
+
+// IterateAccountCreations iterates over the creations of all accounts
+func (ak AccountKeeper) IterateAccountCreations(ctx sdk.Context, cb func(creation types.AccountCreation) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(ak.key), types.AccountCreationKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var creation types.AccountCreation
+		ak.cdc.MustUnmarshalBinaryBare(iterator.Value(), &creation)
+
+		if cb(creation) {
+			break
+		}
+	}
+}
+
+// GetAccountsPage get the accounts in page, the page starts from 1
+func (ak AccountKeeper) GetAccountsPage(ctx sdk.Context, page, limit int) []exported.Account {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(ak.key), types.AddressStoreKeyPrefix)
+	defer iterator.Close()
+
+	res := make([]exported.Account, 0, limit)
+	iteratePage(iterator, page, limit, func(key, value []byte) {
+		res = append(res, ak.decodeAccount(value))
+	})
+
+	return res
+}
+
+// GetAccountsByCreator get the accounts created by creator in page, the page starts from 1
+func (ak AccountKeeper) GetAccountsByCreator(ctx sdk.Context, creator AccountID, page, limit int) []exported.Account {
+	prefix := types.AccountsByCreatorKey(creator)
+
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(ak.key), prefix)
+	defer iterator.Close()
+
+	return ak.getIndexedAccounts(ctx, iterator, len(prefix), page, limit)
+}
+
+// GetAccountsByHeight get the accounts created in the height range [minHeight, maxHeight] in page,
+// the accounts are in order of height and the page starts from 1
+func (ak AccountKeeper) GetAccountsByHeight(ctx sdk.Context, minHeight, maxHeight int64, page, limit int) []exported.Account {
+	if minHeight > maxHeight {
+		return []exported.Account{}
+	}
+
+	iterator := ctx.KVStore(ak.key).Iterator(
+		types.AccountsByHeightKey(minHeight), sdk.PrefixEndBytes(types.AccountsByHeightKey(maxHeight)))
+	defer iterator.Close()
+
+	return ak.getIndexedAccounts(ctx, iterator, len(types.AccountsByHeightKey(minHeight)), page, limit)
+}
+
+// getIndexedAccounts get the accounts in page by a index iterator, the names are the suffixes of keys after prefixLen
+func (ak AccountKeeper) getIndexedAccounts(ctx sdk.Context, iterator sdk.Iterator, prefixLen, page, limit int) []exported.Account {
+	res := make([]exported.Account, 0, limit)
+	iteratePage(iterator, page, limit, func(key, _ []byte) {
+		if account := ak.GetAccountByName(ctx, chainTypes.NewNameFromBytes(key[prefixLen:])); account != nil {
+			res = append(res, account)
+		}
+	})
+
+	return res
+}
+
+// iteratePage calls cb for the entries in page, the entries before the page are skipped
+func iteratePage(iterator sdk.Iterator, page, limit int, cb func(key, value []byte)) {
+	skip := (page - 1) * limit
+
+	for i := 0; iterator.Valid() && i < skip+limit; iterator.Next() {
+		if i >= skip {
+			cb(iterator.Key(), iterator.Value())
+		}
+		i++
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
 	"github.com/KuChainNetwork/kuchain/x/account/exported"
+	"github.com/KuChainNetwork/kuchain/x/account/external"
 	"github.com/KuChainNetwork/kuchain/x/account/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -26,17 +27,30 @@ type AccountKeeper struct {
 
 	// The prototypical Account constructor.
 	proto func() exported.Account
+
+	paramSpace external.ParamsSubspace
 }
 
 // NewAccountKeeper new account keeper
-func NewAccountKeeper(cdc *codec.Codec, key sdk.StoreKey) AccountKeeper {
+func NewAccountKeeper(cdc *codec.Codec, key sdk.StoreKey, paramSpace external.ParamsSubspace) AccountKeeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return AccountKeeper{
-		key:   key,
-		proto: types.NewProtoKuAccount,
-		cdc:   cdc,
+		key:        key,
+		proto:      types.NewProtoKuAccount,
+		cdc:        cdc,
+		paramSpace: paramSpace,
 	}
 }
 
+// Cdc get cdc
+func (ak AccountKeeper) Cdc() *codec.Codec {
+	return ak.cdc
+}
+
 // Logger returns a module-specific logger.
 func (ak AccountKeeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
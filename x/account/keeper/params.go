@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/KuChainNetwork/kuchain/x/account/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NameV2Enabled returns if the accounts with names in version 2 can be created
+func (ak AccountKeeper) NameV2Enabled(ctx sdk.Context) (res bool) {
+	ak.paramSpace.Get(ctx, types.KeyNameV2Enabled, &res)
+	return
+}
+
+// RecordGasPerByte returns the gas cost for each byte of the name records set
+func (ak AccountKeeper) RecordGasPerByte(ctx sdk.Context) (res uint64) {
+	ak.paramSpace.Get(ctx, types.KeyRecordGasPerByte, &res)
+	return
+}
+
+// NameReleaseCooldown returns the duration after which the released name of closed account can be registered again
+func (ak AccountKeeper) NameReleaseCooldown(ctx sdk.Context) (res time.Duration) {
+	ak.paramSpace.Get(ctx, types.KeyNameReleaseCooldown, &res)
+	return
+}
+
+// MsgGasSchedule returns the base gas costs of msgs, empty if the schedule not set
+func (ak AccountKeeper) MsgGasSchedule(ctx sdk.Context) (res types.MsgGasSchedule) {
+	ak.paramSpace.GetIfExists(ctx, types.KeyMsgGasSchedule, &res)
+	return
+}
+
+// GetParams returns the total set of account parameters.
+func (ak AccountKeeper) GetParams(ctx sdk.Context) (params types.Params) {
+	ak.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the account parameters to the param space.
+func (ak AccountKeeper) SetParams(ctx sdk.Context, params types.Params) {
+	ak.paramSpace.SetParamSet(ctx, &params)
+}
@@ -5,6 +5,8 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/account/exported"
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
@@ -21,6 +23,16 @@ func NewQuerier(keeper AccountKeeper) sdk.Querier {
 			return queryAuthByAddress(ctx, req, keeper)
 		case types.QueryAccountsByAuth:
 			return queryAccountsByAuth(ctx, req, keeper)
+		case types.QueryNameRecords:
+			return queryNameRecords(ctx, req, keeper)
+		case types.QueryPrimaryName:
+			return queryPrimaryName(ctx, req, keeper)
+		case types.QueryAccounts:
+			return queryAccounts(ctx, req, keeper)
+		case types.QueryAccountsByCreator:
+			return queryAccountsByCreator(ctx, req, keeper)
+		case types.QueryAccountsByHeight:
+			return queryAccountsByHeight(ctx, req, keeper)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", path[0])
 		}
@@ -83,6 +95,117 @@ func queryAccountsByAuth(ctx sdk.Context, req abci.RequestQuery, ak AccountKeepe
 
 	accounts := ak.GetAccountsByAuth(ctx, params.Auth)
 
+	// all accounts returned if no page for compatibility
+	if params.Page != 0 || params.Limit != 0 {
+		page, limit, err := types.NormalizePage(params.Page, params.Limit)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+
+		start, end := client.Paginate(len(accounts), page, limit, types.DefaultQueryLimit)
+		if start < 0 || end < 0 {
+			accounts = types.Accounts{}
+		} else {
+			accounts = accounts[start:end]
+		}
+	}
+
+	bz, err := codec.MarshalJSONIndent(ak.cdc, accounts)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryNameRecords(ctx sdk.Context, req abci.RequestQuery, ak AccountKeeper) ([]byte, error) {
+	var params types.QueryNameRecordsParams
+	if err := ak.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	if ak.GetAccount(ctx, params.Id) == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", params.Id)
+	}
+
+	bz, err := codec.MarshalJSONIndent(ak.cdc, ak.GetNameRecords(ctx, params.Id))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryPrimaryName(ctx sdk.Context, req abci.RequestQuery, ak AccountKeeper) ([]byte, error) {
+	var params types.QueryPrimaryNameParams
+	if err := ak.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	name, ok := ak.GetPrimaryName(ctx, params.Address)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "primary name of %s no found", params.Address)
+	}
+
+	bz, err := codec.MarshalJSONIndent(ak.cdc, types.PrimaryName{Address: params.Address, Name: name})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryAccounts(ctx sdk.Context, req abci.RequestQuery, ak AccountKeeper) ([]byte, error) {
+	var params types.QueryAccountsParams
+	if err := ak.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	page, limit, err := types.NormalizePage(params.Page, params.Limit)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return marshalAccounts(ak, ak.GetAccountsPage(ctx, page, limit))
+}
+
+func queryAccountsByCreator(ctx sdk.Context, req abci.RequestQuery, ak AccountKeeper) ([]byte, error) {
+	var params types.QueryAccountsByCreatorParams
+	if err := ak.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	if params.Creator.Empty() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "creator cannot be empty")
+	}
+
+	page, limit, err := types.NormalizePage(params.Page, params.Limit)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return marshalAccounts(ak, ak.GetAccountsByCreator(ctx, params.Creator, page, limit))
+}
+
+func queryAccountsByHeight(ctx sdk.Context, req abci.RequestQuery, ak AccountKeeper) ([]byte, error) {
+	var params types.QueryAccountsByHeightParams
+	if err := ak.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	if params.MinHeight < 0 || params.MinHeight > params.MaxHeight {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid height range [%d, %d]", params.MinHeight, params.MaxHeight)
+	}
+
+	page, limit, err := types.NormalizePage(params.Page, params.Limit)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return marshalAccounts(ak, ak.GetAccountsByHeight(ctx, params.MinHeight, params.MaxHeight, page, limit))
+}
+
+func marshalAccounts(ak AccountKeeper, accounts []exported.Account) ([]byte, error) {
 	bz, err := codec.MarshalJSONIndent(ak.cdc, accounts)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/account/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetNameRecords get the records of account, returns empty records if no records set
+func (ak AccountKeeper) GetNameRecords(ctx sdk.Context, id AccountID) types.NameRecords {
+	store := ctx.KVStore(ak.key)
+
+	bz := store.Get(types.NameRecordsKey(id))
+	if bz == nil {
+		return types.NewNameRecords(id)
+	}
+
+	var records types.NameRecords
+	ak.cdc.MustUnmarshalBinaryBare(bz, &records)
+
+	return records
+}
+
+// SetNameRecords set the records of account, the records will be deleted if it is empty
+func (ak AccountKeeper) SetNameRecords(ctx sdk.Context, records types.NameRecords) {
+	store := ctx.KVStore(ak.key)
+
+	if records.Empty() {
+		store.Delete(types.NameRecordsKey(records.Account))
+		return
+	}
+
+	store.Set(types.NameRecordsKey(records.Account), ak.cdc.MustMarshalBinaryBare(records))
+}
+
+// IterateNameRecords iterates over the records of all accounts
+func (ak AccountKeeper) IterateNameRecords(ctx sdk.Context, cb func(records types.NameRecords) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(ak.key), types.NameRecordsKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var records types.NameRecords
+		ak.cdc.MustUnmarshalBinaryBare(iterator.Value(), &records)
+
+		if cb(records) {
+			break
+		}
+	}
+}
+
+// GetPrimaryName get the primary name of address, the name is dropped if the address is no longer
+// the auth of the account.
+func (ak AccountKeeper) GetPrimaryName(ctx sdk.Context, addr AccAddress) (Name, bool) {
+	store := ctx.KVStore(ak.key)
+
+	bz := store.Get(types.PrimaryNameKey(addr))
+	if bz == nil {
+		return Name{}, false
+	}
+
+	name := chainTypes.NewNameFromBytes(bz)
+
+	account := ak.GetAccountByName(ctx, name)
+	if account == nil || !account.GetAuth().Equals(addr) {
+		return Name{}, false
+	}
+
+	return name, true
+}
+
+// SetPrimaryName set the primary name of address, the primary name will be deleted if name is empty
+func (ak AccountKeeper) SetPrimaryName(ctx sdk.Context, addr AccAddress, name Name) {
+	store := ctx.KVStore(ak.key)
+
+	if name.Empty() {
+		store.Delete(types.PrimaryNameKey(addr))
+		return
+	}
+
+	store.Set(types.PrimaryNameKey(addr), name.Bytes())
+}
+
+// DeletePrimaryNameIf delete the primary name of address if it is the name, used when the auth of account changed
+func (ak AccountKeeper) DeletePrimaryNameIf(ctx sdk.Context, addr AccAddress, name Name) {
+	store := ctx.KVStore(ak.key)
+
+	bz := store.Get(types.PrimaryNameKey(addr))
+	if bz != nil && chainTypes.NewNameFromBytes(bz).Eq(name) {
+		store.Delete(types.PrimaryNameKey(addr))
+	}
+}
+
+// IteratePrimaryNames iterates over the primary names of all addresses
+func (ak AccountKeeper) IteratePrimaryNames(ctx sdk.Context, cb func(primary types.PrimaryName) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(ak.key), types.PrimaryNameKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		primary := types.PrimaryName{
+			Address: chainTypes.AccAddress(iterator.Key()[len(types.PrimaryNameKeyPrefix):]),
+			Name:    chainTypes.NewNameFromBytes(iterator.Value()),
+		}
+
+		if cb(primary) {
+			break
+		}
+	}
+}
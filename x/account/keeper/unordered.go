@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/account/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// HasUnorderedTx returns if the unordered tx with the hash is in the replay-protection set
+func (ak AccountKeeper) HasUnorderedTx(ctx sdk.Context, hash []byte) bool {
+	return ctx.KVStore(ak.key).Has(types.UnorderedTxKey(hash))
+}
+
+// AddUnorderedTx adds the unordered tx with the hash to the replay-protection set until expiration
+func (ak AccountKeeper) AddUnorderedTx(ctx sdk.Context, hash []byte, expiration int64) {
+	store := ctx.KVStore(ak.key)
+
+	store.Set(types.UnorderedTxKey(hash), sdk.Uint64ToBigEndian(uint64(expiration)))
+	store.Set(types.UnorderedTxQueueKey(expiration, hash), []byte{})
+}
+
+// PruneUnorderedTxs removes the unordered txs expired before the block time, which cannot be
+// replayed as they are rejected by the expiration.
+func (ak AccountKeeper) PruneUnorderedTxs(ctx sdk.Context) {
+	store := ctx.KVStore(ak.key)
+
+	// the expirations before block time, the txs expire at block time are still valid
+	end := types.UnorderedTxQueueKey(ctx.BlockTime().Unix(), nil)
+	it := store.Iterator(types.UnorderedTxQueueKeyPrefix, end)
+	defer it.Close()
+
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+
+	prefixLen := len(end)
+	for _, key := range keys {
+		store.Delete(types.UnorderedTxKey(key[prefixLen:]))
+		store.Delete(key)
+	}
+}
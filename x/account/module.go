@@ -7,7 +7,6 @@ import (
 	"github.com/KuChainNetwork/kuchain/chain/client/txutil"
 	"github.com/KuChainNetwork/kuchain/chain/genesis"
 	"github.com/KuChainNetwork/kuchain/chain/msg"
-	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
 	"github.com/KuChainNetwork/kuchain/x/account/client/cli"
 	"github.com/KuChainNetwork/kuchain/x/account/client/rest"
 	"github.com/KuChainNetwork/kuchain/x/account/types"
@@ -73,15 +72,20 @@ type AppModule struct {
 	AppModuleBasic
 
 	accountKeeper Keeper
-	assetTransfer chainTypes.AssetTransfer
+	assetKeeper   types.AssetKeeper
+	stakingKeeper types.StakingKeeper
+	distrKeeper   types.DistributionKeeper
 }
 
 // NewAppModule creates a new AppModule object
-func NewAppModule(accountKeeper Keeper, assetTransfer chainTypes.AssetTransfer) AppModule {
+func NewAppModule(accountKeeper Keeper, assetKeeper types.AssetKeeper, stakingKeeper types.StakingKeeper,
+	distrKeeper types.DistributionKeeper) AppModule {
 	return AppModule{
 		AppModuleBasic: NewAppModuleBasic(),
 		accountKeeper:  accountKeeper,
-		assetTransfer:  assetTransfer,
+		assetKeeper:    assetKeeper,
+		stakingKeeper:  stakingKeeper,
+		distrKeeper:    distrKeeper,
 	}
 }
 
@@ -98,7 +102,7 @@ func (AppModule) Route() string { return RouterKey }
 
 // NewHandler returns an sdk.Handler for the account module.
 func (am AppModule) NewHandler() sdk.Handler {
-	return msg.WarpHandler(am.assetTransfer, am.accountKeeper, NewHandler(am.accountKeeper))
+	return msg.WarpHandler(am.assetKeeper, am.accountKeeper, NewHandler(am.accountKeeper, am.assetKeeper, am.stakingKeeper, am.distrKeeper))
 }
 
 // QuerierRoute returns the account module's querier route name.
@@ -122,8 +126,10 @@ func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
 	return types.ModuleCdc.MustMarshalJSON(ExportGenesis(ctx, am.accountKeeper))
 }
 
-// BeginBlock returns the begin blocker for the account module.
-func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
+// BeginBlock returns the begin blocker for the account module, which prunes the expired unordered txs.
+func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
+	am.accountKeeper.PruneUnorderedTxs(ctx)
+}
 
 // EndBlock returns the end blocker for the account module. It returns no validator updates.
 func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
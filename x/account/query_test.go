@@ -0,0 +1,131 @@
+package account_test
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	"github.com/KuChainNetwork/kuchain/x/account"
+	"github.com/KuChainNetwork/kuchain/x/account/exported"
+	accountTypes "github.com/KuChainNetwork/kuchain/x/account/types"
+)
+
+func accountNames(accounts []exported.Account) []string {
+	res := make([]string, 0, len(accounts))
+	for _, a := range accounts {
+		res = append(res, a.GetName().String())
+	}
+	return res
+}
+
+func TestQueryAccountsByPage(t *testing.T) {
+	assets := types.NewInt64Coins(constants.DefaultBondDenom, 10000000000)
+	genAccs := simapp.NewGenesisAccounts(
+		wallet.GetRootAuth(),
+		simapp.NewSimGenesisAccount(account1, addr1).WithAsset(assets),
+		simapp.NewSimGenesisAccount(account2, addr2).WithAsset(assets))
+	app := simapp.SetupWithGenesisAccounts(genAccs)
+
+	created := []struct {
+		creator types.AccountID
+		name    types.Name
+		height  int64
+	}{
+		{creator: account1, name: types.MustName("createdacc01")},
+		{creator: account1, name: types.MustName("createdacc02")},
+		{creator: account2, name: types.MustName("createdacc03")},
+		{creator: account1, name: types.MustName("createdacc04")},
+	}
+
+	Convey("create accounts", t, func() {
+		for i := range created {
+			So(testAccountCreate(t, app, wallet, true, created[i].creator, created[i].name, addr3), ShouldBeNil)
+			created[i].height = app.LastBlockHeight()
+		}
+	})
+
+	Convey("query all accounts by page", t, func() {
+		var all []exported.Account
+		So(queryAccount(app, accountTypes.QueryAccounts, accountTypes.NewQueryAccountsParams(1, accountTypes.MaxQueryLimit), &all), ShouldBeNil)
+		So(accountNames(all), ShouldContain, "createdacc01")
+		So(accountNames(all), ShouldContain, name1.String())
+
+		var page1, page2 []exported.Account
+		So(queryAccount(app, accountTypes.QueryAccounts, accountTypes.NewQueryAccountsParams(1, 2), &page1), ShouldBeNil)
+		So(queryAccount(app, accountTypes.QueryAccounts, accountTypes.NewQueryAccountsParams(2, 2), &page2), ShouldBeNil)
+		So(page1, ShouldHaveLength, 2)
+		So(accountNames(page2), ShouldResemble, accountNames(all[2:4]))
+	})
+
+	Convey("query accounts by creator", t, func() {
+		var accounts []exported.Account
+		params := accountTypes.NewQueryAccountsByCreatorParams(account1, 0, 0)
+		So(queryAccount(app, accountTypes.QueryAccountsByCreator, params, &accounts), ShouldBeNil)
+		So(accountNames(accounts), ShouldResemble, []string{"createdacc01", "createdacc02", "createdacc04"})
+
+		params = accountTypes.NewQueryAccountsByCreatorParams(account1, 2, 2)
+		So(queryAccount(app, accountTypes.QueryAccountsByCreator, params, &accounts), ShouldBeNil)
+		So(accountNames(accounts), ShouldResemble, []string{"createdacc04"})
+	})
+
+	Convey("query accounts by height", t, func() {
+		var accounts []exported.Account
+		params := accountTypes.NewQueryAccountsByHeightParams(created[1].height, created[2].height, 1, 10)
+		So(queryAccount(app, accountTypes.QueryAccountsByHeight, params, &accounts), ShouldBeNil)
+		So(accountNames(accounts), ShouldResemble, []string{"createdacc02", "createdacc03"})
+
+		// the accounts in genesis have no creator and height, so they are not listed
+		params = accountTypes.NewQueryAccountsByHeightParams(0, created[3].height, 1, 10)
+		So(queryAccount(app, accountTypes.QueryAccountsByHeight, params, &accounts), ShouldBeNil)
+		So(accountNames(accounts), ShouldNotContain, name1.String())
+		So(accountNames(accounts), ShouldResemble, []string{"createdacc01", "createdacc02", "createdacc03", "createdacc04"})
+
+		params = accountTypes.NewQueryAccountsByHeightParams(created[2].height, created[1].height, 1, 10)
+		err := queryAccount(app, accountTypes.QueryAccountsByHeight, params, &accounts)
+		So(err, ShouldNotBeNil)
+	})
+
+	Convey("query accounts by auth by page", t, func() {
+		var names accountTypes.Accounts
+		params := accountTypes.NewQueryAccountsByAuthParams(addr3.String())
+		So(queryAccount(app, accountTypes.QueryAccountsByAuth, params, &names), ShouldBeNil)
+		So(names, ShouldHaveLength, 4)
+
+		params.Page, params.Limit = 2, 3
+		So(queryAccount(app, accountTypes.QueryAccountsByAuth, params, &names), ShouldBeNil)
+		So(names, ShouldResemble, accountTypes.Accounts{"createdacc04"})
+	})
+
+	Convey("creations kept by genesis export and import", t, func() {
+		ctx := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
+		ak := app.AccountKeeper()
+
+		exported := account.ExportGenesis(ctx, *ak)
+		So(exported.AccountCreations, ShouldHaveLength, len(created))
+
+		// a creation without creator is not indexed
+		exported.AccountCreations = append(exported.AccountCreations,
+			accountTypes.NewAccountCreation(name1, types.AccountID{}, 0))
+
+		ctx, _ = ctx.CacheContext()
+		for _, c := range created {
+			ak.DeleteAccountCreation(ctx, c.name)
+		}
+		account.InitGenesis(ctx, *ak, account.ModuleCdc.MustMarshalJSON(exported))
+
+		for _, c := range created {
+			creation, found := ak.GetAccountCreation(ctx, c.name)
+			So(found, ShouldBeTrue)
+			So(creation, ShouldResemble, accountTypes.NewAccountCreation(c.name, c.creator, c.height))
+		}
+
+		_, found := ak.GetAccountCreation(ctx, name1)
+		So(found, ShouldBeFalse)
+		So(accountNames(ak.GetAccountsByCreator(ctx, account1, 1, 10)), ShouldResemble,
+			[]string{"createdacc01", "createdacc02", "createdacc04"})
+	})
+}
@@ -0,0 +1,149 @@
+package account_test
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	. "github.com/smartystreets/goconvey/convey"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	"github.com/KuChainNetwork/kuchain/x/account/keeper"
+	accountTypes "github.com/KuChainNetwork/kuchain/x/account/types"
+)
+
+func deliverAccountMsg(t *testing.T, app *simapp.SimApp, shouldBeSuccess bool,
+	payer types.AccountID, auth types.AccAddress, msg sdk.Msg) (sdk.GasInfo, error) {
+	ctxCheck := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1})
+	fee := types.NewInt64Coins(constants.DefaultBondDenom, 100000)
+
+	origAuthSeq, origAuthNum, err := app.AccountKeeper().GetAuthSequence(ctxCheck, auth)
+	So(err, ShouldBeNil)
+
+	header := abci.Header{Height: app.LastBlockHeight() + 1}
+	gasInfo, _, err := simapp.SignCheckDeliver(
+		t, app.Codec(), app.BaseApp,
+		header, payer, fee,
+		[]sdk.Msg{msg}, []uint64{origAuthNum}, []uint64{origAuthSeq},
+		shouldBeSuccess, shouldBeSuccess, wallet.PrivKey(auth))
+
+	return gasInfo, err
+}
+
+func queryAccount(app *simapp.SimApp, path string, params interface{}, res interface{}) error {
+	ctx := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
+	querier := keeper.NewQuerier(*app.AccountKeeper())
+
+	bz, err := querier(ctx, []string{path}, abci.RequestQuery{Data: app.Codec().MustMarshalJSON(params)})
+	if err != nil {
+		return err
+	}
+
+	return app.Codec().UnmarshalJSON(bz, res)
+}
+
+func TestNameRecords(t *testing.T) {
+	assets := types.NewInt64Coins(constants.DefaultBondDenom, 10000000000)
+	genAccs := simapp.NewGenesisAccounts(
+		wallet.GetRootAuth(),
+		simapp.NewSimGenesisAccount(account1, addr1).WithAsset(assets),
+		simapp.NewSimGenesisAccount(account2, addr2).WithAsset(assets))
+	app := simapp.SetupWithGenesisAccounts(genAccs)
+
+	Convey("set text and address records", t, func() {
+		setURL := accountTypes.NewMsgSetTextRecord(addr1, name1, "url", "https://kuchain.io")
+		_, err := deliverAccountMsg(t, app, true, account1, addr1, &setURL)
+		So(err, ShouldBeNil)
+
+		setETH := accountTypes.NewMsgSetAddressRecord(addr1, name1, "eth", "0x7a250d5630b4cf539739df2c5dacb4c659f2488d")
+		_, err = deliverAccountMsg(t, app, true, account1, addr1, &setETH)
+		So(err, ShouldBeNil)
+
+		var records accountTypes.NameRecords
+		So(queryAccount(app, accountTypes.QueryNameRecords, accountTypes.NewQueryNameRecordsParams(account1), &records), ShouldBeNil)
+		So(records.Account.Eq(account1), ShouldBeTrue)
+		So(records.Texts, ShouldResemble, []accountTypes.TextRecord{{Key: "url", Value: "https://kuchain.io"}})
+		So(records.Addresses, ShouldResemble, []accountTypes.AddressRecord{{Chain: "eth", Address: "0x7a250d5630b4cf539739df2c5dacb4c659f2488d"}})
+
+		// empty value deletes the record
+		deleteURL := accountTypes.NewMsgSetTextRecord(addr1, name1, "url", "")
+		_, err = deliverAccountMsg(t, app, true, account1, addr1, &deleteURL)
+		So(err, ShouldBeNil)
+
+		So(queryAccount(app, accountTypes.QueryNameRecords, accountTypes.NewQueryNameRecordsParams(account1), &records), ShouldBeNil)
+		So(records.Texts, ShouldBeEmpty)
+		So(records.Addresses, ShouldHaveLength, 1)
+
+		// no records
+		So(queryAccount(app, accountTypes.QueryNameRecords, accountTypes.NewQueryNameRecordsParams(account2), &records), ShouldBeNil)
+		So(records.Empty(), ShouldBeTrue)
+	})
+
+	Convey("records require the auth of account", t, func() {
+		wrongAuth := accountTypes.NewMsgSetTextRecord(addr2, name1, "url", "https://kuchain.io")
+		_, err := deliverAccountMsg(t, app, false, account2, addr2, &wrongAuth)
+		So(err, simapp.ShouldErrIs, types.ErrMissingAuth)
+
+		emptyKey := accountTypes.NewMsgSetTextRecord(addr1, name1, "", "value")
+		_, err = deliverAccountMsg(t, app, false, account1, addr1, &emptyKey)
+		So(err, simapp.ShouldErrIs, accountTypes.ErrNameRecordKeyInvalid)
+
+		tooLong := accountTypes.NewMsgSetTextRecord(addr1, name1, "desc", strings.Repeat("a", accountTypes.MaxRecordValueLen+1))
+		_, err = deliverAccountMsg(t, app, false, account1, addr1, &tooLong)
+		So(err, simapp.ShouldErrIs, accountTypes.ErrNameRecordValueTooLong)
+	})
+
+	Convey("gas is proportional to the size of records", t, func() {
+		short := accountTypes.NewMsgSetTextRecord(addr1, name1, "desc", "a")
+		shortGas, err := deliverAccountMsg(t, app, true, account1, addr1, &short)
+		So(err, ShouldBeNil)
+
+		long := accountTypes.NewMsgSetTextRecord(addr1, name1, "desc", strings.Repeat("a", accountTypes.MaxRecordValueLen))
+		longGas, err := deliverAccountMsg(t, app, true, account1, addr1, &long)
+		So(err, ShouldBeNil)
+
+		So(longGas.GasUsed-shortGas.GasUsed, ShouldBeGreaterThanOrEqualTo,
+			accountTypes.DefaultRecordGasPerByte*uint64(accountTypes.MaxRecordValueLen-1))
+	})
+}
+
+func TestPrimaryName(t *testing.T) {
+	assets := types.NewInt64Coins(constants.DefaultBondDenom, 10000000000)
+	genAccs := simapp.NewGenesisAccounts(
+		wallet.GetRootAuth(),
+		simapp.NewSimGenesisAccount(account1, addr1).WithAsset(assets),
+		simapp.NewSimGenesisAccount(account2, addr2).WithAsset(assets))
+	app := simapp.SetupWithGenesisAccounts(genAccs)
+
+	Convey("set primary name", t, func() {
+		var primary accountTypes.PrimaryName
+		err := queryAccount(app, accountTypes.QueryPrimaryName, accountTypes.NewQueryPrimaryNameParams(addr1), &primary)
+		So(err, simapp.ShouldErrIs, sdkerrors.ErrUnknownAddress)
+
+		wrongAuth := accountTypes.NewMsgSetPrimaryName(addr2, name1)
+		_, err = deliverAccountMsg(t, app, false, account2, addr2, &wrongAuth)
+		So(err, simapp.ShouldErrIs, accountTypes.ErrPrimaryNameAuthMismatch)
+
+		setPrimary := accountTypes.NewMsgSetPrimaryName(addr1, name1)
+		_, err = deliverAccountMsg(t, app, true, account1, addr1, &setPrimary)
+		So(err, ShouldBeNil)
+
+		So(queryAccount(app, accountTypes.QueryPrimaryName, accountTypes.NewQueryPrimaryNameParams(addr1), &primary), ShouldBeNil)
+		So(primary.Address.Equals(addr1), ShouldBeTrue)
+		So(primary.Name.Eq(name1), ShouldBeTrue)
+	})
+
+	Convey("primary name is dropped after the auth of account updated", t, func() {
+		updateAuth := accountTypes.NewMsgUpdateAccountAuth(addr1, name1, addr3)
+		_, err := deliverAccountMsg(t, app, true, account1, addr1, &updateAuth)
+		So(err, ShouldBeNil)
+
+		var primary accountTypes.PrimaryName
+		err = queryAccount(app, accountTypes.QueryPrimaryName, accountTypes.NewQueryPrimaryNameParams(addr1), &primary)
+		So(err, simapp.ShouldErrIs, sdkerrors.ErrUnknownAddress)
+	})
+}
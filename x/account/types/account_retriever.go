@@ -81,6 +81,100 @@ func (ar AccountRetriever) GetAddAuthWithHeight(add types.AccAddress) (Auth, int
 	return authData, height, nil
 }
 
+// GetAccountsByAuth queries the names of accounts controlled by the auth.
+func (ar AccountRetriever) GetAccountsByAuth(auth types.AccAddress) (Accounts, error) {
+	bs, err := ModuleCdc.MarshalJSON(QueryAccountsByAuthParams{Auth: auth})
+	if err != nil {
+		return nil, err
+	}
+
+	res, _, err := ar.querier.QueryWithData(fmt.Sprintf("custom/%s/%s", QuerierRoute, QueryAccountsByAuth), bs)
+	if err != nil {
+		return nil, err
+	}
+
+	var accounts Accounts
+	if err := ModuleCdc.UnmarshalJSON(res, &accounts); err != nil {
+		return nil, err
+	}
+
+	return accounts, nil
+}
+
+// GetNameRecordsWithHeight queries the records of account.
+func (ar AccountRetriever) GetNameRecordsWithHeight(id types.AccountID) (NameRecords, int64, error) {
+	bs, err := ModuleCdc.MarshalJSON(NewQueryNameRecordsParams(id))
+	if err != nil {
+		return NameRecords{}, 0, err
+	}
+
+	res, height, err := ar.querier.QueryWithData(fmt.Sprintf("custom/%s/%s", QuerierRoute, QueryNameRecords), bs)
+	if err != nil {
+		return NameRecords{}, height, err
+	}
+
+	var records NameRecords
+	if err := ModuleCdc.UnmarshalJSON(res, &records); err != nil {
+		return NameRecords{}, height, err
+	}
+
+	return records, height, nil
+}
+
+// GetPrimaryNameWithHeight queries the primary name of address.
+func (ar AccountRetriever) GetPrimaryNameWithHeight(addr types.AccAddress) (PrimaryName, int64, error) {
+	bs, err := ModuleCdc.MarshalJSON(NewQueryPrimaryNameParams(addr))
+	if err != nil {
+		return PrimaryName{}, 0, err
+	}
+
+	res, height, err := ar.querier.QueryWithData(fmt.Sprintf("custom/%s/%s", QuerierRoute, QueryPrimaryName), bs)
+	if err != nil {
+		return PrimaryName{}, height, err
+	}
+
+	var primary PrimaryName
+	if err := ModuleCdc.UnmarshalJSON(res, &primary); err != nil {
+		return PrimaryName{}, height, err
+	}
+
+	return primary, height, nil
+}
+
+// GetAccountsWithHeight queries the accounts in page.
+func (ar AccountRetriever) GetAccountsWithHeight(page, limit int) ([]exported.Account, int64, error) {
+	return ar.getAccountsWithHeight(QueryAccounts, NewQueryAccountsParams(page, limit))
+}
+
+// GetAccountsByCreatorWithHeight queries the accounts created by creator in page.
+func (ar AccountRetriever) GetAccountsByCreatorWithHeight(creator types.AccountID, page, limit int) ([]exported.Account, int64, error) {
+	return ar.getAccountsWithHeight(QueryAccountsByCreator, NewQueryAccountsByCreatorParams(creator, page, limit))
+}
+
+// GetAccountsByHeightWithHeight queries the accounts created in the height range in page.
+func (ar AccountRetriever) GetAccountsByHeightWithHeight(minHeight, maxHeight int64, page, limit int) ([]exported.Account, int64, error) {
+	return ar.getAccountsWithHeight(QueryAccountsByHeight, NewQueryAccountsByHeightParams(minHeight, maxHeight, page, limit))
+}
+
+func (ar AccountRetriever) getAccountsWithHeight(path string, params interface{}) ([]exported.Account, int64, error) {
+	bs, err := ModuleCdc.MarshalJSON(params)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	res, height, err := ar.querier.QueryWithData(fmt.Sprintf("custom/%s/%s", QuerierRoute, path), bs)
+	if err != nil {
+		return nil, height, err
+	}
+
+	var accounts []exported.Account
+	if err := ModuleCdc.UnmarshalJSON(res, &accounts); err != nil {
+		return nil, height, err
+	}
+
+	return accounts, height, nil
+}
+
 // EnsureExists returns an error if no account exists for the given address else nil.
 func (ar AccountRetriever) EnsureExists(id types.AccountID) error {
 	if _, err := ar.GetAccount(id); err != nil {
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/KuChainNetwork/kuchain/chain/types"
+)
+
+// ClosedName the name of a closed account, it cannot be registered again until released
+type ClosedName struct {
+	Name        types.Name `json:"name" yaml:"name"`
+	Released    bool       `json:"released" yaml:"released"`
+	ReleaseTime time.Time  `json:"release_time" yaml:"release_time"`
+}
+
+// NewClosedName creates a closed name, the name is reserved forever if not released
+func NewClosedName(name types.Name, released bool, releaseTime time.Time) ClosedName {
+	return ClosedName{
+		Name:        name,
+		Released:    released,
+		ReleaseTime: releaseTime,
+	}
+}
+
+// CanRegister returns if the name can be registered again at the time
+func (c ClosedName) CanRegister(now time.Time) bool {
+	return c.Released && !now.Before(c.ReleaseTime)
+}
+
+func (c ClosedName) String() string {
+	if !c.Released {
+		return fmt.Sprintf("%s reserved", c.Name)
+	}
+
+	return fmt.Sprintf("%s released at %s", c.Name, c.ReleaseTime)
+}
@@ -22,6 +22,18 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(&MsgUpdateAccountAuthData{}, "account/upAuthData", nil)
 	cdc.RegisterConcrete(&MsgUpdateAccountAuth{}, "account/upAuth", nil)
 
+	cdc.RegisterConcrete(&MsgSetTextRecordData{}, "account/setTextData", nil)
+	cdc.RegisterConcrete(&MsgSetTextRecord{}, "account/setText", nil)
+
+	cdc.RegisterConcrete(&MsgSetAddressRecordData{}, "account/setAddressData", nil)
+	cdc.RegisterConcrete(&MsgSetAddressRecord{}, "account/setAddress", nil)
+
+	cdc.RegisterConcrete(&MsgSetPrimaryNameData{}, "account/setPrimaryData", nil)
+	cdc.RegisterConcrete(&MsgSetPrimaryName{}, "account/setPrimary", nil)
+
+	cdc.RegisterConcrete(&MsgCloseAccountData{}, "account/closeData", nil)
+	cdc.RegisterConcrete(&MsgCloseAccount{}, "account/close", nil)
+
 	cdc.RegisterConcrete(&KuAccount{}, "kuchain/Account", nil)
 	cdc.RegisterConcrete(&ModuleAccount{}, "kuchain/ModuleAccount", nil)
 
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/chain/types"
+)
+
+// AccountCreation who and when the account was created, used to index the accounts by creator and height,
+// only the accounts created by msg since the indexes were added have a creation
+type AccountCreation struct {
+	Name    types.Name      `json:"name" yaml:"name"`
+	Creator types.AccountID `json:"creator" yaml:"creator"`
+	Height  int64           `json:"height" yaml:"height"`
+}
+
+// NewAccountCreation creates a new AccountCreation
+func NewAccountCreation(name types.Name, creator types.AccountID, height int64) AccountCreation {
+	return AccountCreation{
+		Name:    name,
+		Creator: creator,
+		Height:  height,
+	}
+}
+
+func (c AccountCreation) String() string {
+	return fmt.Sprintf("%s created by %s at %d", c.Name, c.Creator, c.Height)
+}
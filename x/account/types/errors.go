@@ -3,9 +3,17 @@ package types
 import sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 var (
-	ErrAccountHasCreated             = sdkerrors.Register(ModuleName, 1, "account has created")
-	ErrAccountNoFound                = sdkerrors.Register(ModuleName, 2, "account no found")
-	ErrAccountCannotCreateSysAccount = sdkerrors.Register(ModuleName, 3, "cannot create system account by create")
-	ErrAccountNameInvalid            = sdkerrors.Register(ModuleName, 4, "account name is invalid")
-	ErrAccountNameLenInvalid         = sdkerrors.Register(ModuleName, 5, "account name length is invalid")
+	ErrAccountHasCreated              = sdkerrors.Register(ModuleName, 1, "account has created")
+	ErrAccountNoFound                 = sdkerrors.Register(ModuleName, 2, "account no found")
+	ErrAccountCannotCreateSysAccount  = sdkerrors.Register(ModuleName, 3, "cannot create system account by create")
+	ErrAccountNameInvalid             = sdkerrors.Register(ModuleName, 4, "account name is invalid")
+	ErrAccountNameLenInvalid          = sdkerrors.Register(ModuleName, 5, "account name length is invalid")
+	ErrAccountNameV2Disabled          = sdkerrors.Register(ModuleName, 6, "account name in version 2 is disabled")
+	ErrNameRecordsTooMany             = sdkerrors.Register(ModuleName, 7, "too many records of account")
+	ErrNameRecordKeyInvalid           = sdkerrors.Register(ModuleName, 8, "record key is invalid")
+	ErrNameRecordValueTooLong         = sdkerrors.Register(ModuleName, 9, "record value is too long")
+	ErrPrimaryNameAuthMismatch        = sdkerrors.Register(ModuleName, 10, "primary name is not controlled by address")
+	ErrAccountCloseBeneficiaryInvalid = sdkerrors.Register(ModuleName, 11, "beneficiary of closing account is invalid")
+	ErrAccountCannotClose             = sdkerrors.Register(ModuleName, 12, "account cannot be closed")
+	ErrAccountNameClosed              = sdkerrors.Register(ModuleName, 13, "name of closed account is not released")
 )
@@ -5,8 +5,18 @@ const (
 
 	EventTypeCreateAccount     = "account.create"
 	EventTypeUpdateAccountAuth = "account.authupdate"
+	EventTypeSetTextRecord     = "account.settext"
+	EventTypeSetAddressRecord  = "account.setaddress"
+	EventTypeSetPrimaryName    = "account.setprimary"
+	EventTypeCloseAccount      = "account.close"
 
-	AttributeKeyCreator = "creator"
-	AttributeKeyAccount = "account"
-	AttributeKeyAuth    = "auth"
+	AttributeKeyCreator     = "creator"
+	AttributeKeyAccount     = "account"
+	AttributeKeyAuth        = "auth"
+	AttributeKeyKey         = "key"
+	AttributeKeyChain       = "chain"
+	AttributeKeyAddress     = "address"
+	AttributeKeyBeneficiary = "beneficiary"
+	AttributeKeyAmount      = "amount"
+	AttributeKeyReleaseTime = "release_time"
 )
@@ -0,0 +1,25 @@
+package types
+
+import (
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AssetKeeper defines the expected asset keeper to sweep the coins of closing account (noalias)
+type AssetKeeper interface {
+	chainTypes.AssetTransfer
+	GetCoins(ctx sdk.Context, account AccountID) (Coins, error)
+	GetCoinPowers(ctx sdk.Context, account AccountID) Coins
+	GetLockedCoins(ctx sdk.Context, account AccountID) (Coins, error)
+	HasCreatedCoins(ctx sdk.Context, creator Name) bool
+}
+
+// StakingKeeper defines the expected staking keeper to check the closing account (noalias)
+type StakingKeeper interface {
+	IsAccountStaking(ctx sdk.Context, account AccountID) bool
+}
+
+// DistributionKeeper defines the expected distribution keeper to clear the references to the closing account (noalias)
+type DistributionKeeper interface {
+	ClearWithdrawAddrs(ctx sdk.Context, account AccountID)
+}
@@ -0,0 +1,97 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+)
+
+// MsgGas the base gas cost of msgs by the router and action of msg, the cost applies to all actions
+// of the router if action is empty
+type MsgGas struct {
+	Router string `json:"router" yaml:"router"`
+	Action string `json:"action" yaml:"action"`
+	Gas    uint64 `json:"gas" yaml:"gas"`
+}
+
+// NewMsgGas creates a new MsgGas
+func NewMsgGas(router, action string, gas uint64) MsgGas {
+	return MsgGas{
+		Router: router,
+		Action: action,
+		Gas:    gas,
+	}
+}
+
+// MsgGasSchedule the base gas costs of msgs, charged in ante for each msg in tx
+type MsgGasSchedule []MsgGas
+
+// DefaultMsgGasSchedule the default schedule charging account creation and coin creation
+func DefaultMsgGasSchedule() MsgGasSchedule {
+	return MsgGasSchedule{
+		NewMsgGas(RouterKey, MsgCreateAccountData{}.Type().String(), constants.GasMsgCreateAccount),
+		NewMsgGas("asset", "create@asset", constants.GasMsgCreateCoin),
+	}
+}
+
+// GasOf returns the base gas cost of msg, the cost of the router is used if no cost for the action
+func (s MsgGasSchedule) GasOf(router, action string) uint64 {
+	var (
+		routerGas uint64
+		hasRouter bool
+	)
+
+	for _, g := range s {
+		if g.Router != router {
+			continue
+		}
+
+		if g.Action == action {
+			return g.Gas
+		}
+
+		if g.Action == "" {
+			routerGas, hasRouter = g.Gas, true
+		}
+	}
+
+	if hasRouter {
+		return routerGas
+	}
+
+	return 0
+}
+
+// Validate validates the schedule, the router should not be empty and no duplicated costs
+func (s MsgGasSchedule) Validate() error {
+	seen := make(map[string]bool, len(s))
+
+	for _, g := range s {
+		if g.Router == "" {
+			return fmt.Errorf("router of msg gas cannot be empty")
+		}
+
+		key := g.Router + "/" + g.Action
+		if seen[key] {
+			return fmt.Errorf("duplicated msg gas of %s", key)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
+
+func (s MsgGasSchedule) String() string {
+	var sb strings.Builder
+
+	for _, g := range s {
+		action := g.Action
+		if action == "" {
+			action = "*"
+		}
+		fmt.Fprintf(&sb, "%s/%s: %d\n", g.Router, action, g.Gas)
+	}
+
+	return sb.String()
+}
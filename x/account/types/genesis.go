@@ -8,7 +8,14 @@ import (
 
 // GenesisState genesis state for account module
 type GenesisState struct {
+	Params   Params                   `json:"params"`
 	Accounts exported.GenesisAccounts `json:"accounts"`
+
+	NameRecords  []NameRecords `json:"name_records,omitempty"`
+	PrimaryNames []PrimaryName `json:"primary_names,omitempty"`
+	ClosedNames  []ClosedName  `json:"closed_names,omitempty"`
+
+	AccountCreations []AccountCreation `json:"account_creations,omitempty"`
 }
 
 func (g GenesisState) ValidateGenesis(bz json.RawMessage) error {
@@ -18,6 +25,7 @@ func (g GenesisState) ValidateGenesis(bz json.RawMessage) error {
 // DefaultGenesisState get default genesis state for account module
 func DefaultGenesisState() GenesisState {
 	res := GenesisState{
+		Params:   DefaultParams(),
 		Accounts: exported.GenesisAccounts{},
 	}
 
@@ -27,6 +35,7 @@ func DefaultGenesisState() GenesisState {
 // NewGenesisState new genesis state by genesis accounts, for test
 func NewGenesisState(accs []exported.GenesisAccount) GenesisState {
 	return GenesisState{
+		Params:   DefaultParams(),
 		Accounts: accs,
 	}
 }
@@ -2,6 +2,7 @@ package types
 
 import (
 	"github.com/KuChainNetwork/kuchain/chain/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
@@ -25,6 +26,30 @@ var (
 	// Auth - Accounts store prefix
 	AuthAccountsStoreKeyPerfix = []byte{0x0C}
 
+	// UnorderedTxQueueKeyPrefix prefix for unordered txs by expiration, to prune the expired
+	UnorderedTxQueueKeyPrefix = []byte{0x0D}
+
+	// UnorderedTxKeyPrefix prefix for unordered txs by hash, to reject the replays
+	UnorderedTxKeyPrefix = []byte{0x0E}
+
+	// NameRecordsKeyPrefix prefix for the records of accounts
+	NameRecordsKeyPrefix = []byte{0x0F}
+
+	// PrimaryNameKeyPrefix prefix for the primary name of address, the reverse mapping of names
+	PrimaryNameKeyPrefix = []byte{0x10}
+
+	// ClosedNameKeyPrefix prefix for the names of closed accounts, which cannot be registered before released
+	ClosedNameKeyPrefix = []byte{0x11}
+
+	// AccountCreationKeyPrefix prefix for the creator and height of accounts
+	AccountCreationKeyPrefix = []byte{0x12}
+
+	// AccountByCreatorKeyPrefix prefix for the index of accounts by creator
+	AccountByCreatorKeyPrefix = []byte{0x13}
+
+	// AccountByHeightKeyPrefix prefix for the index of accounts by the height created
+	AccountByHeightKeyPrefix = []byte{0x14}
+
 	// GlobalAccountNumberKey param key for global account number
 	GlobalAccountNumberKey = types.MustName("g.account.number").Value
 )
@@ -48,3 +73,55 @@ func AuthSeqStoreKey(addr types.AccAddress) []byte {
 func AuthAccountsStoreKey(auth types.AccAddress) []byte {
 	return append(AuthAccountsStoreKeyPerfix, auth.Bytes()...)
 }
+
+// UnorderedTxKey key of the expiration of unordered tx by hash
+func UnorderedTxKey(hash []byte) []byte {
+	return append(UnorderedTxKeyPrefix, hash...)
+}
+
+// UnorderedTxQueueKey key of unordered tx in queue by expiration, the expiration is in big endian
+// so the txs are iterated in order of expiration.
+func UnorderedTxQueueKey(expiration int64, hash []byte) []byte {
+	return append(append(UnorderedTxQueueKeyPrefix, sdk.Uint64ToBigEndian(uint64(expiration))...), hash...)
+}
+
+// NameRecordsKey key of the records of account
+func NameRecordsKey(id types.AccountID) []byte {
+	return append(NameRecordsKeyPrefix, id.StoreKey()...)
+}
+
+// PrimaryNameKey key of the primary name of address
+func PrimaryNameKey(addr types.AccAddress) []byte {
+	return append(PrimaryNameKeyPrefix, addr.Bytes()...)
+}
+
+// ClosedNameKey key of the name of closed account
+func ClosedNameKey(name types.Name) []byte {
+	return append(ClosedNameKeyPrefix, name.Bytes()...)
+}
+
+// AccountCreationKey key of the creation of account
+func AccountCreationKey(name types.Name) []byte {
+	return append(AccountCreationKeyPrefix, name.Bytes()...)
+}
+
+// AccountsByCreatorKey prefix of the accounts created by creator
+func AccountsByCreatorKey(creator types.AccountID) []byte {
+	return append(AccountByCreatorKeyPrefix, creator.StoreKey()...)
+}
+
+// AccountByCreatorKey key of account in the index by creator
+func AccountByCreatorKey(creator types.AccountID, name types.Name) []byte {
+	return append(AccountsByCreatorKey(creator), name.Bytes()...)
+}
+
+// AccountsByHeightKey prefix of the accounts created at height, the height is in big endian
+// so the accounts are iterated in order of height.
+func AccountsByHeightKey(height int64) []byte {
+	return append(AccountByHeightKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// AccountByHeightKey key of account in the index by height
+func AccountByHeightKey(height int64, name types.Name) []byte {
+	return append(AccountsByHeightKey(height), name.Bytes()...)
+}
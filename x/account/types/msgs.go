@@ -10,6 +10,8 @@ import (
 const RouterKey = ModuleName
 
 var _, _ types.KuMsgData = (*MsgCreateAccountData)(nil), (*MsgUpdateAccountAuthData)(nil)
+var _, _, _ types.KuMsgData = (*MsgSetTextRecordData)(nil), (*MsgSetAddressRecordData)(nil), (*MsgSetPrimaryNameData)(nil)
+var _ types.KuMsgData = (*MsgCloseAccountData)(nil)
 
 // MsgCreateAccountData the data struct of MsgCreateAccount
 type MsgCreateAccountData struct {
@@ -128,3 +130,244 @@ func (msg MsgUpdateAccountAuth) ValidateBasic() error {
 
 	return nil
 }
+
+// MsgSetTextRecordData the data struct of MsgSetTextRecord
+type MsgSetTextRecordData struct {
+	Name  types.Name `json:"name" yaml:"name"`
+	Key   string     `json:"key" yaml:"key"`
+	Value string     `json:"value" yaml:"value"`
+}
+
+func (MsgSetTextRecordData) Type() types.Name { return types.MustName("settext") }
+
+func (msg MsgSetTextRecordData) Sender() AccountID {
+	return NewAccountIDFromName(msg.Name)
+}
+
+// MsgSetTextRecord set a text record of account, the record will be deleted if value is empty
+type MsgSetTextRecord struct {
+	types.KuMsg
+}
+
+// NewMsgSetTextRecord create msg to set a text record of account
+func NewMsgSetTextRecord(auth types.AccAddress, name types.Name, key, value string) MsgSetTextRecord {
+	return MsgSetTextRecord{
+		*msg.MustNewKuMsg(
+			types.MustName(RouterKey),
+			msg.WithAuth(auth),
+			msg.WithData(Cdc(), &MsgSetTextRecordData{
+				Name:  name,
+				Key:   key,
+				Value: value,
+			}),
+		),
+	}
+}
+
+func (msg MsgSetTextRecord) GetData() (MsgSetTextRecordData, error) {
+	res := MsgSetTextRecordData{}
+	if err := msg.UnmarshalData(Cdc(), &res); err != nil {
+		return MsgSetTextRecordData{}, sdkerrors.Wrapf(types.ErrKuMsgDataUnmarshal, "%s", err.Error())
+	}
+	return res, nil
+}
+
+func (msg MsgSetTextRecord) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateTransfer(); err != nil {
+		return err
+	}
+
+	data, err := msg.GetData()
+	if err != nil {
+		return err
+	}
+
+	if data.Name.Empty() {
+		return types.ErrNameNilString
+	}
+
+	return ValidateTextRecord(data.Key, data.Value)
+}
+
+// MsgSetAddressRecordData the data struct of MsgSetAddressRecord
+type MsgSetAddressRecordData struct {
+	Name    types.Name `json:"name" yaml:"name"`
+	Chain   string     `json:"chain" yaml:"chain"`
+	Address string     `json:"address" yaml:"address"`
+}
+
+func (MsgSetAddressRecordData) Type() types.Name { return types.MustName("setaddress") }
+
+func (msg MsgSetAddressRecordData) Sender() AccountID {
+	return NewAccountIDFromName(msg.Name)
+}
+
+// MsgSetAddressRecord link a address in external chain to account, the record will be deleted if address is empty
+type MsgSetAddressRecord struct {
+	types.KuMsg
+}
+
+// NewMsgSetAddressRecord create msg to set a external chain address of account
+func NewMsgSetAddressRecord(auth types.AccAddress, name types.Name, chain, address string) MsgSetAddressRecord {
+	return MsgSetAddressRecord{
+		*msg.MustNewKuMsg(
+			types.MustName(RouterKey),
+			msg.WithAuth(auth),
+			msg.WithData(Cdc(), &MsgSetAddressRecordData{
+				Name:    name,
+				Chain:   chain,
+				Address: address,
+			}),
+		),
+	}
+}
+
+func (msg MsgSetAddressRecord) GetData() (MsgSetAddressRecordData, error) {
+	res := MsgSetAddressRecordData{}
+	if err := msg.UnmarshalData(Cdc(), &res); err != nil {
+		return MsgSetAddressRecordData{}, sdkerrors.Wrapf(types.ErrKuMsgDataUnmarshal, "%s", err.Error())
+	}
+	return res, nil
+}
+
+func (msg MsgSetAddressRecord) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateTransfer(); err != nil {
+		return err
+	}
+
+	data, err := msg.GetData()
+	if err != nil {
+		return err
+	}
+
+	if data.Name.Empty() {
+		return types.ErrNameNilString
+	}
+
+	return ValidateAddressRecord(data.Chain, data.Address)
+}
+
+// MsgSetPrimaryNameData the data struct of MsgSetPrimaryName
+type MsgSetPrimaryNameData struct {
+	Address types.AccAddress `json:"address" yaml:"address"`
+	Name    types.Name       `json:"name" yaml:"name"`
+}
+
+func (MsgSetPrimaryNameData) Type() types.Name { return types.MustName("setprimary") }
+
+func (msg MsgSetPrimaryNameData) Sender() AccountID {
+	return types.NewAccountIDFromAccAdd(msg.Address)
+}
+
+// MsgSetPrimaryName set the primary name of address, which should be a account with the address as auth,
+// the primary name will be cleared if name is empty
+type MsgSetPrimaryName struct {
+	types.KuMsg
+}
+
+// NewMsgSetPrimaryName create msg to set the primary name of address
+func NewMsgSetPrimaryName(address types.AccAddress, name types.Name) MsgSetPrimaryName {
+	return MsgSetPrimaryName{
+		*msg.MustNewKuMsg(
+			types.MustName(RouterKey),
+			msg.WithAuth(address),
+			msg.WithData(Cdc(), &MsgSetPrimaryNameData{
+				Address: address,
+				Name:    name,
+			}),
+		),
+	}
+}
+
+func (msg MsgSetPrimaryName) GetData() (MsgSetPrimaryNameData, error) {
+	res := MsgSetPrimaryNameData{}
+	if err := msg.UnmarshalData(Cdc(), &res); err != nil {
+		return MsgSetPrimaryNameData{}, sdkerrors.Wrapf(types.ErrKuMsgDataUnmarshal, "%s", err.Error())
+	}
+	return res, nil
+}
+
+func (msg MsgSetPrimaryName) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateTransfer(); err != nil {
+		return err
+	}
+
+	data, err := msg.GetData()
+	if err != nil {
+		return err
+	}
+
+	if data.Address.Empty() {
+		return types.ErrKuMsgAccountIDNil
+	}
+
+	return nil
+}
+
+// MsgCloseAccountData the data struct of MsgCloseAccount
+type MsgCloseAccountData struct {
+	Name        types.Name      `json:"name" yaml:"name"`
+	Beneficiary types.AccountID `json:"beneficiary" yaml:"beneficiary"`
+	ReleaseName bool            `json:"release_name" yaml:"release_name"`
+}
+
+func (MsgCloseAccountData) Type() types.Name { return types.MustName("close@account") }
+
+func (msg MsgCloseAccountData) Sender() AccountID {
+	return NewAccountIDFromName(msg.Name)
+}
+
+// MsgCloseAccount close a account which has no locked, power or staking coins, the remaining coins are
+// swept to the beneficiary. The name can be registered again after the cooldown if released, otherwise
+// it is reserved forever.
+type MsgCloseAccount struct {
+	types.KuMsg
+}
+
+// NewMsgCloseAccount create msg to close account
+func NewMsgCloseAccount(auth types.AccAddress, name types.Name, beneficiary types.AccountID, releaseName bool) MsgCloseAccount {
+	return MsgCloseAccount{
+		*msg.MustNewKuMsg(
+			types.MustName(RouterKey),
+			msg.WithAuth(auth),
+			msg.WithData(Cdc(), &MsgCloseAccountData{
+				Name:        name,
+				Beneficiary: beneficiary,
+				ReleaseName: releaseName,
+			}),
+		),
+	}
+}
+
+func (msg MsgCloseAccount) GetData() (MsgCloseAccountData, error) {
+	res := MsgCloseAccountData{}
+	if err := msg.UnmarshalData(Cdc(), &res); err != nil {
+		return MsgCloseAccountData{}, sdkerrors.Wrapf(types.ErrKuMsgDataUnmarshal, "%s", err.Error())
+	}
+	return res, nil
+}
+
+func (msg MsgCloseAccount) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateTransfer(); err != nil {
+		return err
+	}
+
+	data, err := msg.GetData()
+	if err != nil {
+		return err
+	}
+
+	if data.Name.Empty() {
+		return types.ErrNameNilString
+	}
+
+	if data.Beneficiary.Empty() {
+		return types.ErrKuMsgAccountIDNil
+	}
+
+	if data.Beneficiary.Eq(NewAccountIDFromName(data.Name)) {
+		return ErrAccountCloseBeneficiaryInvalid
+	}
+
+	return nil
+}
@@ -1,6 +1,116 @@
 package types
 
+import (
+	"fmt"
+	"time"
+
+	"github.com/KuChainNetwork/kuchain/x/account/external"
+	"gopkg.in/yaml.v2"
+)
+
 const (
 	// DefaultParamspace for params keeper
 	DefaultParamspace = ModuleName
+
+	// DefaultNameV2Enabled names in version 2 cannot be created by default
+	DefaultNameV2Enabled = false
+
+	// DefaultRecordGasPerByte gas cost for each byte of records set, same as the cost of tx size
+	DefaultRecordGasPerByte uint64 = 10
+
+	// DefaultNameReleaseCooldown the name of closed account can be registered again after 30 days
+	DefaultNameReleaseCooldown = time.Hour * 24 * 30
 )
+
+// Parameter store keys
+var (
+	KeyNameV2Enabled       = []byte("NameV2Enabled")
+	KeyRecordGasPerByte    = []byte("RecordGasPerByte")
+	KeyNameReleaseCooldown = []byte("NameReleaseCooldown")
+	KeyMsgGasSchedule      = []byte("MsgGasSchedule")
+)
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() external.ParamsKeyTable {
+	return external.ParamNewKeyTable().RegisterParamSet(&Params{})
+}
+
+// Params defines the parameters for the account module
+type Params struct {
+	// NameV2Enabled if the accounts with names in version 2 can be created
+	NameV2Enabled bool `json:"name_v2_enabled" yaml:"name_v2_enabled"`
+	// RecordGasPerByte gas cost for each byte of the name records set, 0 disables it
+	RecordGasPerByte uint64 `json:"record_gas_per_byte" yaml:"record_gas_per_byte"`
+	// NameReleaseCooldown duration after which the released name of closed account can be registered again
+	NameReleaseCooldown time.Duration `json:"name_release_cooldown" yaml:"name_release_cooldown"`
+	// MsgGasSchedule base gas cost of msgs by router and action, charged in ante
+	MsgGasSchedule MsgGasSchedule `json:"msg_gas_schedule" yaml:"msg_gas_schedule"`
+}
+
+// NewParams creates a new Params object
+func NewParams(nameV2Enabled bool, recordGasPerByte uint64, nameReleaseCooldown time.Duration, msgGasSchedule MsgGasSchedule) Params {
+	return Params{
+		NameV2Enabled:       nameV2Enabled,
+		RecordGasPerByte:    recordGasPerByte,
+		NameReleaseCooldown: nameReleaseCooldown,
+		MsgGasSchedule:      msgGasSchedule,
+	}
+}
+
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() external.ParamSetPairs {
+	return external.ParamSetPairs{
+		external.ParamNewParamSetPair(KeyNameV2Enabled, &p.NameV2Enabled, validateNameV2Enabled),
+		external.ParamNewParamSetPair(KeyRecordGasPerByte, &p.RecordGasPerByte, validateRecordGasPerByte),
+		external.ParamNewParamSetPair(KeyNameReleaseCooldown, &p.NameReleaseCooldown, validateNameReleaseCooldown),
+		external.ParamNewParamSetPair(KeyMsgGasSchedule, &p.MsgGasSchedule, validateMsgGasSchedule),
+	}
+}
+
+// DefaultParams returns the default parameters for the account module.
+func DefaultParams() Params {
+	return NewParams(DefaultNameV2Enabled, DefaultRecordGasPerByte, DefaultNameReleaseCooldown, DefaultMsgGasSchedule())
+}
+
+func validateNameV2Enabled(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateRecordGasPerByte(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateNameReleaseCooldown(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("name release cooldown must not be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateMsgGasSchedule(i interface{}) error {
+	v, ok := i.(MsgGasSchedule)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.Validate()
+}
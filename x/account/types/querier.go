@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
 )
 
@@ -9,7 +11,18 @@ const (
 	QueryAccount        = "account"
 	QueryAuthByAddress  = "authByAddress"
 	QueryAccountsByAuth = "accountsByAuth"
+	QueryNameRecords    = "records"
+	QueryPrimaryName    = "primaryName"
 	QueryParams         = "params"
+
+	QueryAccounts          = "accounts"
+	QueryAccountsByCreator = "accountsByCreator"
+	QueryAccountsByHeight  = "accountsByHeight"
+
+	// DefaultQueryLimit default number of accounts in a page
+	DefaultQueryLimit = 100
+	// MaxQueryLimit max number of accounts in a page
+	MaxQueryLimit = 1000
 )
 
 // QueryAccountParams defines the params for querying accounts.
@@ -42,12 +55,91 @@ func NewQueryAddAuthParams(address chainTypes.AccAddress) QueryAuthByAddressPara
 	return QueryAuthByAddressParams{Address: address}
 }
 
-// QueryAuthSeqParams defines the params for querying accounts.
+// QueryAccountsByAuthParams defines the params for querying accounts by auth, all accounts returned if page is 0.
 type QueryAccountsByAuthParams struct {
-	Auth chainTypes.AccAddress
+	Auth  chainTypes.AccAddress
+	Page  int `json:"page,omitempty"`
+	Limit int `json:"limit,omitempty"`
 }
 
 // NewQueryAccountsByAuthParams creates a new instance of QueryAccountsByAuthParams.
 func NewQueryAccountsByAuthParams(auth string) QueryAccountsByAuthParams {
 	return QueryAccountsByAuthParams{Auth: chainTypes.MustAccAddressFromBech32(auth)}
 }
+
+// QueryNameRecordsParams defines the params for querying the records of account.
+type QueryNameRecordsParams struct {
+	Id chainTypes.AccountID
+}
+
+// NewQueryNameRecordsParams creates a new instance of QueryNameRecordsParams.
+func NewQueryNameRecordsParams(id chainTypes.AccountID) QueryNameRecordsParams {
+	return QueryNameRecordsParams{Id: id}
+}
+
+// QueryPrimaryNameParams defines the params for querying the primary name of address.
+type QueryPrimaryNameParams struct {
+	Address chainTypes.AccAddress
+}
+
+// NewQueryPrimaryNameParams creates a new instance of QueryPrimaryNameParams.
+func NewQueryPrimaryNameParams(address chainTypes.AccAddress) QueryPrimaryNameParams {
+	return QueryPrimaryNameParams{Address: address}
+}
+
+// QueryAccountsParams defines the params for querying all accounts by page.
+type QueryAccountsParams struct {
+	Page  int `json:"page"`
+	Limit int `json:"limit"`
+}
+
+// NewQueryAccountsParams creates a new instance of QueryAccountsParams.
+func NewQueryAccountsParams(page, limit int) QueryAccountsParams {
+	return QueryAccountsParams{Page: page, Limit: limit}
+}
+
+// QueryAccountsByCreatorParams defines the params for querying the accounts created by creator.
+type QueryAccountsByCreatorParams struct {
+	Creator chainTypes.AccountID `json:"creator"`
+	Page    int                  `json:"page"`
+	Limit   int                  `json:"limit"`
+}
+
+// NewQueryAccountsByCreatorParams creates a new instance of QueryAccountsByCreatorParams.
+func NewQueryAccountsByCreatorParams(creator chainTypes.AccountID, page, limit int) QueryAccountsByCreatorParams {
+	return QueryAccountsByCreatorParams{Creator: creator, Page: page, Limit: limit}
+}
+
+// QueryAccountsByHeightParams defines the params for querying the accounts created in the height range [MinHeight, MaxHeight].
+type QueryAccountsByHeightParams struct {
+	MinHeight int64 `json:"min_height"`
+	MaxHeight int64 `json:"max_height"`
+	Page      int   `json:"page"`
+	Limit     int   `json:"limit"`
+}
+
+// NewQueryAccountsByHeightParams creates a new instance of QueryAccountsByHeightParams.
+func NewQueryAccountsByHeightParams(minHeight, maxHeight int64, page, limit int) QueryAccountsByHeightParams {
+	return QueryAccountsByHeightParams{MinHeight: minHeight, MaxHeight: maxHeight, Page: page, Limit: limit}
+}
+
+// NormalizePage returns the page and limit used by query, the page starts from 1
+func NormalizePage(page, limit int) (int, int, error) {
+	if page < 0 || limit < 0 {
+		return 0, 0, fmt.Errorf("invalid page %d or limit %d", page, limit)
+	}
+
+	if page == 0 {
+		page = 1
+	}
+
+	if limit == 0 {
+		limit = DefaultQueryLimit
+	}
+
+	if limit > MaxQueryLimit {
+		limit = MaxQueryLimit
+	}
+
+	return page, limit, nil
+}
@@ -0,0 +1,206 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/KuChainNetwork/kuchain/chain/types"
+)
+
+const (
+	// MaxTextRecords max number of text records of a account
+	MaxTextRecords = 32
+	// MaxAddressRecords max number of external chain addresses of a account
+	MaxAddressRecords = 32
+
+	// MaxRecordKeyLen max length of the key of text records and the chain of address records
+	MaxRecordKeyLen = 64
+	// MaxRecordValueLen max length of the value of text records, the msg data should not exceed KuMsgMaxDataLen
+	MaxRecordValueLen = 512
+	// MaxRecordAddressLen max length of the external chain addresses
+	MaxRecordAddressLen = 128
+)
+
+// TextRecord a key/value text record of account, such as "url", "avatar" or "email"
+type TextRecord struct {
+	Key   string `json:"key" yaml:"key"`
+	Value string `json:"value" yaml:"value"`
+}
+
+// AddressRecord a address in external chain linked to the account, the chain is a symbol like "btc" or "eth"
+type AddressRecord struct {
+	Chain   string `json:"chain" yaml:"chain"`
+	Address string `json:"address" yaml:"address"`
+}
+
+// NameRecords the records attached to a account, which are resolved by the name of account
+type NameRecords struct {
+	Account   types.AccountID `json:"account" yaml:"account"`
+	Texts     []TextRecord    `json:"texts" yaml:"texts"`
+	Addresses []AddressRecord `json:"addresses" yaml:"addresses"`
+}
+
+// NewNameRecords creates empty records for account
+func NewNameRecords(account types.AccountID) NameRecords {
+	return NameRecords{
+		Account:   account,
+		Texts:     []TextRecord{},
+		Addresses: []AddressRecord{},
+	}
+}
+
+// Empty returns if there are no records
+func (r NameRecords) Empty() bool {
+	return len(r.Texts) == 0 && len(r.Addresses) == 0
+}
+
+// Text returns the value of text record by key
+func (r NameRecords) Text(key string) (string, bool) {
+	for _, t := range r.Texts {
+		if t.Key == key {
+			return t.Value, true
+		}
+	}
+
+	return "", false
+}
+
+// Address returns the address in external chain
+func (r NameRecords) Address(chain string) (string, bool) {
+	for _, a := range r.Addresses {
+		if a.Chain == chain {
+			return a.Address, true
+		}
+	}
+
+	return "", false
+}
+
+// SetText sets the text record, the record will be deleted if value is empty
+func (r *NameRecords) SetText(key, value string) error {
+	for i, t := range r.Texts {
+		if t.Key != key {
+			continue
+		}
+
+		if value == "" {
+			r.Texts = append(r.Texts[:i], r.Texts[i+1:]...)
+		} else {
+			r.Texts[i].Value = value
+		}
+
+		return nil
+	}
+
+	if value == "" {
+		return nil
+	}
+
+	if len(r.Texts) >= MaxTextRecords {
+		return ErrNameRecordsTooMany
+	}
+
+	r.Texts = append(r.Texts, TextRecord{Key: key, Value: value})
+	return nil
+}
+
+// SetAddress sets the address in external chain, the record will be deleted if address is empty
+func (r *NameRecords) SetAddress(chain, address string) error {
+	for i, a := range r.Addresses {
+		if a.Chain != chain {
+			continue
+		}
+
+		if address == "" {
+			r.Addresses = append(r.Addresses[:i], r.Addresses[i+1:]...)
+		} else {
+			r.Addresses[i].Address = address
+		}
+
+		return nil
+	}
+
+	if address == "" {
+		return nil
+	}
+
+	if len(r.Addresses) >= MaxAddressRecords {
+		return ErrNameRecordsTooMany
+	}
+
+	r.Addresses = append(r.Addresses, AddressRecord{Chain: chain, Address: address})
+	return nil
+}
+
+// Validate validates the records in genesis
+func (r NameRecords) Validate() error {
+	if _, ok := r.Account.ToName(); !ok {
+		return fmt.Errorf("records account %s should be a name", r.Account)
+	}
+
+	if len(r.Texts) > MaxTextRecords || len(r.Addresses) > MaxAddressRecords {
+		return ErrNameRecordsTooMany
+	}
+
+	for _, t := range r.Texts {
+		if err := ValidateTextRecord(t.Key, t.Value); err != nil {
+			return err
+		}
+	}
+
+	for _, a := range r.Addresses {
+		if err := ValidateAddressRecord(a.Chain, a.Address); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r NameRecords) String() string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "Records of %s:\n  Texts:\n", r.Account)
+	for _, t := range r.Texts {
+		fmt.Fprintf(&sb, "    %s: %s\n", t.Key, t.Value)
+	}
+
+	sb.WriteString("  Addresses:\n")
+	for _, a := range r.Addresses {
+		fmt.Fprintf(&sb, "    %s: %s\n", a.Chain, a.Address)
+	}
+
+	return sb.String()
+}
+
+// PrimaryName the reverse mapping from a address to the name of account it controls
+type PrimaryName struct {
+	Address types.AccAddress `json:"address" yaml:"address"`
+	Name    types.Name       `json:"name" yaml:"name"`
+}
+
+// ValidateTextRecord validates the key and value of text record, empty value to delete the record
+func ValidateTextRecord(key, value string) error {
+	if key == "" || len(key) > MaxRecordKeyLen {
+		return ErrNameRecordKeyInvalid
+	}
+
+	if len(value) > MaxRecordValueLen {
+		return ErrNameRecordValueTooLong
+	}
+
+	return nil
+}
+
+// ValidateAddressRecord validates the chain and address of address record, empty address to delete the record
+func ValidateAddressRecord(chain, address string) error {
+	if chain == "" || len(chain) > MaxRecordKeyLen {
+		return ErrNameRecordKeyInvalid
+	}
+
+	if len(address) > MaxRecordAddressLen {
+		return ErrNameRecordValueTooLong
+	}
+
+	return nil
+}
@@ -123,6 +123,8 @@ func (a AssetKeeper) Issue(ctx sdk.Context, creator, symbol types.Name, amount t
 		return sdkerrors.Wrap(err, "issue set coins")
 	}
 
+	emitCoinsReceived(ctx, creatorAccount, NewCoins(amount))
+
 	return nil
 }
 
@@ -149,6 +151,8 @@ func (a AssetKeeper) Burn(ctx sdk.Context, id types.AccountID, amount types.Coin
 		return sdkerrors.Wrap(err, "burn set coins")
 	}
 
+	emitCoinsSpent(ctx, id, NewCoins(amount))
+
 	return nil
 }
 
@@ -200,6 +204,9 @@ func (a AssetKeeper) Transfer(ctx sdk.Context, from, to types.AccountID, amount
 		return sdkerrors.Wrap(err, "set from coins")
 	}
 
+	emitCoinsSpent(ctx, from, amount)
+	emitCoinsReceived(ctx, to, amount)
+
 	return nil
 }
 
@@ -209,7 +216,14 @@ func (a AssetKeeper) GenesisCoins(ctx sdk.Context, account types.AccountID, coin
 			return err
 		}
 	}
-	return a.setCoins(ctx, account, coins)
+
+	if err := a.setCoins(ctx, account, coins); err != nil {
+		return err
+	}
+
+	emitCoinsReceived(ctx, account, coins)
+
+	return nil
 }
 
 func (k AssetKeeper) GetStoreKey() sdk.StoreKey {
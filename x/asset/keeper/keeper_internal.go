@@ -113,6 +113,28 @@ func (a AssetKeeper) setCoins(ctx sdk.Context, account types.AccountID, coin typ
 	return nil
 }
 
+// emitCoinsSpent emits the event of the coins spent by the account
+func emitCoinsSpent(ctx sdk.Context, spender types.AccountID, amount types.Coins) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCoinSpent,
+			sdk.NewAttribute(types.AttributeKeySpender, spender.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+}
+
+// emitCoinsReceived emits the event of the coins received by the account
+func emitCoinsReceived(ctx sdk.Context, receiver types.AccountID, amount types.Coins) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCoinReceived,
+			sdk.NewAttribute(types.AttributeKeyReceiver, receiver.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+}
+
 func (a AssetKeeper) getCoins(ctx sdk.Context, account types.AccountID) (types.Coins, error) {
 	store := ctx.KVStore(a.key)
 	bz := store.Get(types.CoinStoreKey(account))
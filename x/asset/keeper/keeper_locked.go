@@ -186,6 +186,11 @@ func (a AssetKeeper) GetLockCoins(ctx sdk.Context, account types.AccountID) (typ
 	return all, lockedStat.Lockeds, nil
 }
 
+// GetLockedCoins get all locked coins of account
+func (a AssetKeeper) GetLockedCoins(ctx sdk.Context, account types.AccountID) (types.Coins, error) {
+	return a.getCoinsLocked(ctx, account)
+}
+
 // CheckIsCanUseCoins check if the account can use this coins
 func (a AssetKeeper) CheckIsCanUseCoins(ctx sdk.Context, account types.AccountID, coins types.Coins) error {
 	currentCoins, err := a.getCoins(ctx, account)
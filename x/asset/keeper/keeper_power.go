@@ -128,6 +128,8 @@ func (a AssetKeeper) CoinsToPower(ctx sdk.Context, from, to types.AccountID, amt
 		return sdkerrors.Wrapf(err, "CoinsToPower: set coins power in add %s error", to)
 	}
 
+	emitCoinsSpent(ctx, from, amt)
+
 	return nil
 }
 
@@ -148,5 +150,11 @@ func (a AssetKeeper) ExerciseCoinPower(ctx sdk.Context, id types.AccountID, amt
 		return sdkerrors.Wrapf(err, "get coins error")
 	}
 
-	return sdkerrors.Wrapf(a.setCoins(ctx, id, coins.Add(amt)), "set coins in exercise error")
+	if err := a.setCoins(ctx, id, coins.Add(amt)); err != nil {
+		return sdkerrors.Wrapf(err, "set coins in exercise error")
+	}
+
+	emitCoinsReceived(ctx, id, NewCoins(amt))
+
+	return nil
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/KuChainNetwork/kuchain/chain/constants"
 	"github.com/KuChainNetwork/kuchain/chain/types"
 	"github.com/KuChainNetwork/kuchain/test/simapp"
+	assetTypes "github.com/KuChainNetwork/kuchain/x/asset/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
@@ -53,3 +54,31 @@ func TestAssetTransfer(t *testing.T) {
 		So(err, ShouldBeNil)
 	})
 }
+
+func TestAssetCoinEvents(t *testing.T) {
+	app, ctx := createTestApp()
+
+	Convey("test coin events of transfer and fee", t, func() {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		amt := types.NewInt64Coins(constants.DefaultBondDenom, 100)
+		to := types.NewAccountIDFromAccAdd(wallet.NewAccAddress())
+		So(app.AssetKeeper().Transfer(ctx, account1, to, amt), ShouldBeNil)
+		So(app.AssetKeeper().PayFee(ctx, to, types.NewInt64Coins(constants.DefaultBondDenom, 10)), ShouldBeNil)
+
+		events := ctx.EventManager().Events()
+		So(events, ShouldHaveLength, 3)
+
+		So(events[0].Type, ShouldEqual, assetTypes.EventTypeCoinSpent)
+		So(string(events[0].Attributes[0].Value), ShouldEqual, account1.String())
+		So(string(events[0].Attributes[1].Value), ShouldEqual, amt.String())
+
+		So(events[1].Type, ShouldEqual, assetTypes.EventTypeCoinReceived)
+		So(string(events[1].Attributes[0].Value), ShouldEqual, to.String())
+
+		// the fee is spent as coin power of the fee collector
+		So(events[2].Type, ShouldEqual, assetTypes.EventTypeCoinSpent)
+		So(string(events[2].Attributes[0].Value), ShouldEqual, to.String())
+		So(string(events[2].Attributes[1].Value), ShouldEqual, types.NewInt64Coins(constants.DefaultBondDenom, 10).String())
+	})
+}
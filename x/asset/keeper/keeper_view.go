@@ -34,6 +34,22 @@ func (a AssetKeeper) GetCoinPowers(ctx sdk.Context, account types.AccountID) typ
 	return res
 }
 
+// HasCreatedCoins returns if the account is the creator of any coin
+func (a AssetKeeper) HasCreatedCoins(ctx sdk.Context, creator types.Name) bool {
+	prefix := append(types.GetKeyPrefix(types.CoinStatStoreKeyPrefix), creator.Bytes()...)
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(a.key), prefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		// the stat of coin without creator may have the same key as the prefix
+		if len(iterator.Key()) > len(prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // GetCoinPower get coin power by account id and coin demon
 func (a AssetKeeper) GetCoinPower(ctx sdk.Context, account types.AccountID, creator, symbol types.Name) (types.Coin, error) {
 	coins, err := a.getCoinsPower(ctx, account)
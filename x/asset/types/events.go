@@ -11,6 +11,11 @@ const (
 	EventTypeLock     = "lock"
 	EventTypeUnlock   = "unlock"
 	EventTypeExercise = "exercise"
+
+	// EventTypeCoinSpent and EventTypeCoinReceived are emitted by the keeper for each change of
+	// the coins of an account, so the balances can be tracked by events
+	EventTypeCoinSpent    = "coin_spent"
+	EventTypeCoinReceived = "coin_received"
 )
 
 const (
@@ -27,4 +32,6 @@ const (
 	AttributeKeyIssueToHeight = "issueToHeight"
 	AttributeKeyInit          = "init"
 	AttributeKeyDescription   = "desc"
+	AttributeKeySpender       = "spender"
+	AttributeKeyReceiver      = "receiver"
 )
@@ -23,6 +23,7 @@ const (
 	QueryDelegatorValidators         = types.QueryDelegatorValidators
 	QueryWithdrawAddr                = types.QueryWithdrawAddr
 	QueryCommunityPool               = types.QueryCommunityPool
+	QueryDelegatorRewardsByDenom     = types.QueryDelegatorRewardsByDenom
 	DefaultParamspace                = types.DefaultParamspace
 )
 
@@ -84,6 +85,7 @@ var (
 	NewQueryDelegatorParams                    = types.NewQueryDelegatorParams
 	NewQueryDelegatorWithdrawAddrParams        = types.NewQueryDelegatorWithdrawAddrParams
 	NewQueryDelegatorTotalRewardsResponse      = types.NewQueryDelegatorTotalRewardsResponse
+	NewQueryDelegatorRewardsByDenomResponse    = types.NewQueryDelegatorRewardsByDenomResponse
 	NewDelegationDelegatorReward               = types.NewDelegationDelegatorReward
 	NewValidatorHistoricalRewards              = types.NewValidatorHistoricalRewards
 	NewValidatorCurrentRewards                 = types.NewValidatorCurrentRewards
@@ -142,6 +144,7 @@ type (
 	QueryDelegatorParams                   = types.QueryDelegatorParams
 	QueryDelegatorWithdrawAddrParams       = types.QueryDelegatorWithdrawAddrParams
 	QueryDelegatorTotalRewardsResponse     = types.QueryDelegatorTotalRewardsResponse
+	QueryDelegatorRewardsByDenomResponse   = types.QueryDelegatorRewardsByDenomResponse
 	DelegationDelegatorReward              = types.DelegationDelegatorReward
 	ValidatorHistoricalRewards             = types.ValidatorHistoricalRewards
 	ValidatorCurrentRewards                = types.ValidatorCurrentRewards
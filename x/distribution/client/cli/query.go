@@ -32,6 +32,7 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryValidatorCommission(queryRoute, cdc),
 		GetCmdQueryValidatorSlashes(queryRoute, cdc),
 		GetCmdQueryDelegatorRewards(queryRoute, cdc),
+		GetCmdQueryDelegatorRewardsByDenom(queryRoute, cdc),
 		GetCmdQueryWithDrawAddr(queryRoute, cdc),
 	)...)
 
@@ -269,6 +270,50 @@ $ %s query kudistribution rewards jack validatorName
 	}
 }
 
+// GetCmdQueryDelegatorRewardsByDenom implements the query delegator rewards grouped by denom command.
+func GetCmdQueryDelegatorRewardsByDenom(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "rewards-by-denom [delegator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query kudistribution delegator pending rewards per denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query pending rewards earned by a delegator in each denom, with the amount from every validator.
+
+Example:
+$ %s query kudistribution rewards-by-denom jack
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delegatorAddr, err := chainTypes.NewAccountIDFromStr(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryDelegatorParams(delegatorAddr))
+			if err != nil {
+				return fmt.Errorf("failed to marshal params: %w", err)
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDelegatorRewardsByDenom)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var result types.QueryDelegatorRewardsByDenomResponse
+			if err = cdc.UnmarshalJSON(res, &result); err != nil {
+				return fmt.Errorf("failed to unmarshal response: %w", err)
+			}
+
+			return cliCtx.PrintOutput(result)
+		},
+	}
+}
+
 // GetCmdQueryCommunityPool returns the command for fetching community pool info
 func GetCmdQueryCommunityPool(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
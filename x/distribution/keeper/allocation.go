@@ -22,7 +22,6 @@ func (k Keeper) AllocateTokens(
 	// (and distributed to the previous proposer)
 	feeCollector := k.supplyKeeper.GetModuleAccount(ctx, k.feeCollectorName)
 	feesCollectedInt := k.BankKeeper.GetCoinPowers(ctx, feeCollector.GetID())
-	feesCollected := chainTypes.NewDecCoinsFromCoins(feesCollectedInt...)
 
 	// transfer collected fees to the distribution module account
 	err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, feesCollectedInt)
@@ -30,9 +29,15 @@ func (k Keeper) AllocateTokens(
 		panic(err)
 	}
 
+	// only the fees in reward denoms are distributed to validators and delegators
+	rewardFees, nonRewardFees := k.splitRewardFees(ctx, feesCollectedInt)
+	feesCollected := chainTypes.NewDecCoinsFromCoins(rewardFees...)
+
 	// temporary workaround to keep CanWithdrawInvariant happy
 	// general discussions here: https://github.com/cosmos/cosmos-sdk/issues/2906#issuecomment-441867634
 	feePool := k.GetFeePool(ctx)
+	feePool = k.allocateNonRewardFees(ctx, feePool, nonRewardFees)
+
 	if totalPreviousPower == 0 {
 		feePool.CommunityPool = feePool.CommunityPool.Add(feesCollected...)
 		k.SetFeePool(ctx, feePool)
@@ -143,3 +148,47 @@ func (k Keeper) AllocateTokensToValidator(ctx sdk.Context, val types.StakingExpo
 		"outstanding", outstanding)
 	k.SetValidatorOutstandingRewards(ctx, val.GetOperatorAccountID(), outstanding)
 }
+
+// splitRewardFees splits the fees collected into the coins in reward denoms and the others
+func (k Keeper) splitRewardFees(ctx sdk.Context, fees Coins) (reward, nonReward Coins) {
+	params := k.GetParams(ctx)
+
+	reward, nonReward = Coins{}, Coins{}
+	for _, fee := range fees {
+		if params.IsRewardDenom(fee.Denom) {
+			reward = append(reward, fee)
+		} else {
+			nonReward = append(nonReward, fee)
+		}
+	}
+
+	return reward, nonReward
+}
+
+// allocateNonRewardFees sends the fees not in reward denoms to community pool or burn them
+func (k Keeper) allocateNonRewardFees(ctx sdk.Context, feePool types.FeePool, fees Coins) types.FeePool {
+	if fees.IsZero() {
+		return feePool
+	}
+
+	destination := k.GetNonRewardDenomsTo(ctx)
+	switch destination {
+	case types.NonRewardDenomsToBurn:
+		// burn coins by sending them to black hole, as supply BurnCoins does
+		if err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.SupplyBlackHole, fees); err != nil {
+			panic(err)
+		}
+	default:
+		feePool.CommunityPool = feePool.CommunityPool.Add(chainTypes.NewDecCoinsFromCoins(fees...)...)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeNonRewardFees,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, fees.String()),
+			sdk.NewAttribute(types.AttributeKeyDestination, destination),
+		),
+	)
+
+	return feePool
+}
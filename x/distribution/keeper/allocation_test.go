@@ -9,6 +9,9 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/KuChainNetwork/kuchain/x/asset"
+	assettypes "github.com/KuChainNetwork/kuchain/x/asset/types"
+	"github.com/KuChainNetwork/kuchain/x/distribution/types"
 	"github.com/KuChainNetwork/kuchain/x/staking"
 	sktypes "github.com/KuChainNetwork/kuchain/x/staking/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -361,3 +364,78 @@ func TestAllocateTokensTruncation(t *testing.T) {
 	require.True(t, k.GetValidatorOutstandingRewards(ctx, Acc8).Rewards.IsValid())
 	require.True(t, k.GetValidatorOutstandingRewards(ctx, Acc10).Rewards.IsValid())
 }
+
+// createFeeToken creates a coin not in the reward denoms for fees
+func createFeeToken(t *testing.T, ctx sdk.Context, ask asset.Keeper) string {
+	tokenName, _ := chainType.NewName("feetoken")
+	denom := chainType.CoinDenom(MasterName, tokenName)
+
+	maxSupply, _ := sdk.NewIntFromString("100000000000000000000")
+	err := ask.Create(ctx, MasterName, tokenName, assettypes.NewCoin(denom, maxSupply),
+		true, true, true, 0, assettypes.NewCoin(denom, sdk.ZeroInt()), []byte("feetoken"))
+	require.NoError(t, err)
+
+	return denom
+}
+
+func TestSplitRewardFees(t *testing.T) {
+	ctx, _, k, _, _, ask := CreateTestInputDefault(t, false, 1000)
+	feeDenom := createFeeToken(t, ctx, ask)
+
+	fees := chainType.NewCoins(
+		chainType.NewCoin(constants.DefaultBondDenom, sdk.NewInt(100)),
+		chainType.NewCoin(feeDenom, sdk.NewInt(50)),
+	)
+
+	// all fees are rewards if no whitelist
+	reward, nonReward := k.splitRewardFees(ctx, fees)
+	require.Equal(t, fees, reward)
+	require.True(t, nonReward.IsZero())
+
+	params := k.GetParams(ctx)
+	params.RewardDenoms = []string{constants.DefaultBondDenom}
+	k.SetParams(ctx, params)
+
+	reward, nonReward = k.splitRewardFees(ctx, fees)
+	require.Equal(t, chainType.NewCoins(chainType.NewCoin(constants.DefaultBondDenom, sdk.NewInt(100))), reward)
+	require.Equal(t, chainType.NewCoins(chainType.NewCoin(feeDenom, sdk.NewInt(50))), nonReward)
+}
+
+func TestAllocateNonRewardFees(t *testing.T) {
+	for _, destination := range []string{types.NonRewardDenomsToCommunityPool, types.NonRewardDenomsToBurn} {
+		ctx, _, k, _, supplyKeeper, ask := CreateTestInputDefault(t, false, 1000)
+		feeDenom := createFeeToken(t, ctx, ask)
+
+		params := k.GetParams(ctx)
+		params.RewardDenoms = []string{constants.DefaultBondDenom}
+		params.NonRewardDenomsTo = destination
+		k.SetParams(ctx, params)
+
+		rewardFees := chainType.NewCoins(chainType.NewCoin(constants.DefaultBondDenom, sdk.NewInt(100)))
+		nonRewardFees := chainType.NewCoins(chainType.NewCoin(feeDenom, sdk.NewInt(50)))
+
+		feeCollector := supplyKeeper.GetModuleAccount(ctx, k.feeCollectorName)
+		_, err := ask.IssueCoinPower(ctx, feeCollector.GetID(), rewardFees.Add(nonRewardFees...))
+		require.NoError(t, err)
+
+		// no power, all fees in reward denoms go to community pool
+		k.AllocateTokens(ctx, 0, 0, nil, nil)
+
+		distrAcc := supplyKeeper.GetModuleAccount(ctx, types.ModuleName)
+		blackHole := supplyKeeper.GetModuleAccount(ctx, types.SupplyBlackHole)
+		communityPool := k.GetFeePool(ctx).CommunityPool
+
+		require.True(t, ask.GetCoinPowers(ctx, feeCollector.GetID()).IsZero())
+
+		switch destination {
+		case types.NonRewardDenomsToCommunityPool:
+			require.Equal(t, chainType.NewDecCoinsFromCoins(rewardFees.Add(nonRewardFees...)...), communityPool)
+			require.Equal(t, rewardFees.Add(nonRewardFees...), ask.GetCoinPowers(ctx, distrAcc.GetID()))
+			require.True(t, ask.GetCoinPowers(ctx, blackHole.GetID()).IsZero())
+		case types.NonRewardDenomsToBurn:
+			require.Equal(t, chainType.NewDecCoinsFromCoins(rewardFees...), communityPool)
+			require.Equal(t, rewardFees, ask.GetCoinPowers(ctx, distrAcc.GetID()))
+			require.Equal(t, nonRewardFees, ask.GetCoinPowers(ctx, blackHole.GetID()))
+		}
+	}
+}
@@ -87,6 +87,30 @@ func (k Keeper) SetWithdrawAddr(ctx sdk.Context, delegatorId chainTypes.AccountI
 	return nil
 }
 
+// ClearWithdrawAddrs removes the withdraw addresses set by or pointing to the closed account,
+// the delegators withdrawing to it will withdraw to themselves, so a new owner of the name
+// cannot receive their rewards.
+func (k Keeper) ClearWithdrawAddrs(ctx sdk.Context, id chainTypes.AccountID) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDelegatorWithdrawAddrKey(id))
+
+	iter := sdk.KVStorePrefixIterator(store, types.DelegatorWithdrawAddrPrefix)
+	defer iter.Close()
+
+	keys := make([][]byte, 0)
+	for ; iter.Valid(); iter.Next() {
+		if chainTypes.NewAccountIDFromByte(iter.Value()).Eq(id) {
+			keys = append(keys, iter.Key())
+		}
+	}
+
+	for _, key := range keys {
+		k.Logger(ctx).Info("clear withdraw address of closed account",
+			"delegator", types.GetDelegatorWithdrawInfoAddressUseAccountId(key), "withdraw", id)
+		store.Delete(key)
+	}
+}
+
 // withdraw rewards from a delegation
 func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delAddr chainTypes.AccountID, valAddr chainTypes.AccountID) (Coins, error) {
 
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"encoding/json"
 	"testing"
 
 	chainType "github.com/KuChainNetwork/kuchain/chain/types"
@@ -281,3 +282,29 @@ func TestGetTotalRewards(t *testing.T) {
 
 	require.Equal(t, expectedRewards, totalRewards)
 }
+
+func TestParamsFromOldGenesis(t *testing.T) {
+	ctx, _, keeper, _, _, _ := CreateTestInputDefault(t, false, 1000)
+
+	// the genesis before the reward denoms params added
+	var genesis map[string]interface{}
+	require.NoError(t, json.Unmarshal(keeper.cdc.MustMarshalJSON(types.DefaultGenesisState()), &genesis))
+	params := genesis["params"].(map[string]interface{})
+	delete(params, "reward_denoms")
+	delete(params, "non_reward_denoms_to")
+
+	bz, err := json.Marshal(genesis)
+	require.NoError(t, err)
+
+	var gs types.GenesisState
+	require.NoError(t, keeper.cdc.UnmarshalJSON(bz, &gs))
+	require.NoError(t, types.ValidateGenesis(gs))
+
+	keeper.SetParams(ctx, gs.Params)
+
+	res := keeper.GetParams(ctx)
+	require.NoError(t, res.ValidateBasic())
+	require.Equal(t, types.NonRewardDenomsToCommunityPool, res.NonRewardDenomsTo)
+	require.Equal(t, types.NonRewardDenomsToCommunityPool, keeper.GetNonRewardDenomsTo(ctx))
+	require.True(t, res.IsRewardDenom("kuchain/kcs"))
+}
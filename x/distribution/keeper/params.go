@@ -5,9 +5,18 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// GetParams returns the total set of distribution parameters.
+// GetParams returns the total set of distribution parameters, the params
+// added after genesis are default if not in the param space.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
+	params.RewardDenoms = []string{}
+	for _, pair := range params.ParamSetPairs() {
+		k.paramSpace.GetIfExists(ctx, pair.Key, pair.Value)
+	}
+
+	if params.NonRewardDenomsTo == "" {
+		params.NonRewardDenomsTo = types.NonRewardDenomsToCommunityPool
+	}
+
 	return params
 }
 
@@ -41,3 +50,20 @@ func (k Keeper) GetWithdrawAddrEnabled(ctx sdk.Context) (enabled bool) {
 	k.paramSpace.Get(ctx, types.ParamStoreKeyWithdrawAddrEnabled, &enabled)
 	return enabled
 }
+
+// GetRewardDenoms returns the denoms of fees which distributed to stakers,
+// empty means all denoms.
+func (k Keeper) GetRewardDenoms(ctx sdk.Context) (denoms []string) {
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreKeyRewardDenoms, &denoms)
+	return denoms
+}
+
+// GetNonRewardDenomsTo returns where the fees not in reward denoms go,
+// community pool if not set.
+func (k Keeper) GetNonRewardDenomsTo(ctx sdk.Context) (to string) {
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreKeyNonRewardDenomsTo, &to)
+	if to == "" {
+		to = types.NonRewardDenomsToCommunityPool
+	}
+	return to
+}
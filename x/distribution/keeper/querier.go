@@ -41,6 +41,9 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QueryCommunityPool:
 			return queryCommunityPool(ctx, path[1:], req, k)
 
+		case types.QueryDelegatorRewardsByDenom:
+			return queryDelegatorRewardsByDenom(ctx, path[1:], req, k)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", path[0])
 		}
@@ -214,6 +217,38 @@ func queryDelegatorTotalRewards(ctx sdk.Context, _ []string, req abci.RequestQue
 	return bz, nil
 }
 
+func queryDelegatorRewardsByDenom(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryDelegatorParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	// cache-wrap context as to not persist state changes during querying
+	ctx, _ = ctx.CacheContext()
+
+	var delRewards []types.DelegationDelegatorReward
+
+	k.stakingKeeper.IterateDelegations(
+		ctx, params.DelegatorAddress,
+		func(_ int64, del types.StakingExportedDelegationI) (stop bool) {
+			valID := del.GetValidatorAccountID()
+			val := k.stakingKeeper.Validator(ctx, valID)
+			endingPeriod := k.IncrementValidatorPeriod(ctx, val)
+			delReward := k.CalculateDelegationRewards(ctx, val, del, endingPeriod)
+
+			delRewards = append(delRewards, types.NewDelegationDelegatorReward(valID, delReward))
+			return false
+		},
+	)
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, types.NewQueryDelegatorRewardsByDenomResponse(delRewards))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
 func queryDelegatorValidators(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
 	var params types.QueryDelegatorParams
 	err := k.cdc.UnmarshalJSON(req.Data, &params)
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	chainType "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/distribution/types"
+	"github.com/KuChainNetwork/kuchain/x/staking"
+	sktypes "github.com/KuChainNetwork/kuchain/x/staking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestQueryDelegatorRewardsByDenom(t *testing.T) {
+	ctx, ak, k, sk, supplyKeeper, ask := CreateTestInputDefault(t, false, 1000)
+	sh := staking.NewHandler(sk)
+	feeDenom := createFeeToken(t, ctx, ask)
+
+	// create validator with 50% commission
+	commission := staking.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+
+	Acc1Name, _ := Acc1.ToName()
+	Acc1Auth, _ := ak.GetAuth(ctx, Acc1Name)
+	Acc2Name, _ := Acc2.ToName()
+	Acc2pubk := AccPubk[Acc2Name.String()]
+
+	msg := sktypes.NewKuMsgCreateValidator(Acc1Auth, Acc2, Acc2pubk, GetDescription(), commission.MaxRate, Acc1)
+	kuCtx := chainType.NewKuMsgCtx(ctx, nil, nil)
+
+	_, err := sh(kuCtx, msg)
+	require.NoError(t, err)
+
+	initCoins := chainType.NewCoins(chainType.NewCoin(constants.DefaultBondDenom, chainType.NewInt(100)))
+	err = ask.Transfer(ctx, Acc1, supplyKeeper.GetModuleAccount(ctx, staking.ModuleName).GetID(), initCoins)
+	require.NoError(t, err)
+
+	msg1 := sktypes.NewKuMsgDelegate(Acc1Auth, Acc1, Acc2, chainType.NewCoin(constants.DefaultBondDenom, chainType.NewInt(100)))
+	_, err = sh(kuCtx.WithTransfMsg(msg1), msg1)
+	require.NoError(t, err)
+
+	// next block: rewards in two denoms, half to the only delegator
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	k.AllocateTokensToValidator(ctx, sk.Validator(ctx, Acc2), chainType.NewDecCoins(
+		chainType.NewDecCoin(constants.DefaultBondDenom, sdk.NewInt(10)),
+		chainType.NewDecCoin(feeDenom, sdk.NewInt(4)),
+	))
+
+	querier := NewQuerier(k)
+	bz, err := querier(ctx, []string{types.QueryDelegatorRewardsByDenom}, abci.RequestQuery{
+		Data: k.cdc.MustMarshalJSON(types.NewQueryDelegatorParams(Acc1)),
+	})
+	require.NoError(t, err)
+
+	var res types.QueryDelegatorRewardsByDenomResponse
+	require.NoError(t, k.cdc.UnmarshalJSON(bz, &res))
+
+	expected := types.NewQueryDelegatorRewardsByDenomResponse([]types.DelegationDelegatorReward{
+		types.NewDelegationDelegatorReward(Acc2, chainType.NewDecCoins(
+			chainType.NewDecCoin(constants.DefaultBondDenom, sdk.NewInt(5)),
+			chainType.NewDecCoin(feeDenom, sdk.NewInt(2)),
+		)),
+	})
+	require.Equal(t, expected, res)
+	require.Len(t, res.Rewards, 2)
+
+	// no delegations, no rewards
+	bz, err = querier(ctx, []string{types.QueryDelegatorRewardsByDenom}, abci.RequestQuery{
+		Data: k.cdc.MustMarshalJSON(types.NewQueryDelegatorParams(Acc3)),
+	})
+	require.NoError(t, err)
+	require.NoError(t, k.cdc.UnmarshalJSON(bz, &res))
+	require.Empty(t, res.Rewards)
+
+	// invalid params
+	_, err = querier(ctx, []string{types.QueryDelegatorRewardsByDenom}, abci.RequestQuery{Data: []byte("{")})
+	require.Error(t, err)
+}
@@ -143,7 +143,7 @@ func CreateTestInputAdvanced(t *testing.T, isCheckTx bool, initPower int64,
 	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
 
 	pk := params.NewKeeper(cdc, keyParams, tkeyParams)
-	AccountKeeper := account.NewAccountKeeper(cdc, sdk.NewKVStoreKey(account.StoreKey))
+	AccountKeeper := account.NewAccountKeeper(cdc, sdk.NewKVStoreKey(account.StoreKey), pk.Subspace(account.DefaultParamspace))
 
 	mAccPerms := map[string][]string{
 		fee.CollectorName:         nil,
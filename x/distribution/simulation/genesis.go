@@ -73,6 +73,8 @@ func RandomizedGenState(simState *module.SimulationState) {
 			BaseProposerReward:  baseProposerReward,
 			BonusProposerReward: bonusProposerReward,
 			WithdrawAddrEnabled: withdrawEnabled,
+			RewardDenoms:        []string{},
+			NonRewardDenomsTo:   types.NonRewardDenomsToCommunityPool,
 		},
 	}
 
@@ -59,6 +59,10 @@ var (
 	StakingNewMsgDelegate        = staking.NewMsgDelegate
 )
 
+const (
+	SupplyBlackHole = supply.BlackHole
+)
+
 var (
 	SupplyRegisterCodec         = supply.RegisterCodec
 	SupplyNewModuleAddress      = supply.NewModuleAddress
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
 	params "github.com/KuChainNetwork/kuchain/x/params/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"gopkg.in/yaml.v2"
@@ -19,6 +20,14 @@ var (
 	ParamStoreKeyBaseProposerReward  = []byte("baseproposerreward")
 	ParamStoreKeyBonusProposerReward = []byte("bonusproposerreward")
 	ParamStoreKeyWithdrawAddrEnabled = []byte("withdrawaddrenabled")
+	ParamStoreKeyRewardDenoms        = []byte("rewarddenoms")
+	ParamStoreKeyNonRewardDenomsTo   = []byte("nonrewarddenomsto")
+)
+
+// Destinations for collected fees in denoms not listed in RewardDenoms
+const (
+	NonRewardDenomsToCommunityPool = "community_pool"
+	NonRewardDenomsToBurn          = "burn"
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -32,6 +41,13 @@ type Params struct {
 	BaseProposerReward  Dec  `json:"base_proposer_reward" yaml:"base_proposer_reward"`
 	BonusProposerReward Dec  `json:"bonus_proposer_reward" yaml:"bonus_proposer_reward"`
 	WithdrawAddrEnabled bool `json:"withdraw_addr_enabled,omitempty" yaml:"withdraw_addr_enabled"`
+
+	// RewardDenoms whitelist the fee denoms distributed to validators and delegators,
+	// if empty, all denoms collected will be distributed.
+	RewardDenoms []string `json:"reward_denoms" yaml:"reward_denoms"`
+	// NonRewardDenomsTo is where the fees in denoms not in RewardDenoms go, community_pool or burn,
+	// empty is community_pool, as in the params before it added
+	NonRewardDenomsTo string `json:"non_reward_denoms_to" yaml:"non_reward_denoms_to"`
 }
 
 // DefaultParams returns default distribution parameters
@@ -41,7 +57,24 @@ func DefaultParams() Params {
 		BaseProposerReward:  sdk.NewDecWithPrec(1, 2), // 1%
 		BonusProposerReward: sdk.NewDecWithPrec(4, 2), // 4%
 		WithdrawAddrEnabled: true,
+		RewardDenoms:        []string{},
+		NonRewardDenomsTo:   NonRewardDenomsToCommunityPool,
+	}
+}
+
+// IsRewardDenom returns true if the fees in denom should be distributed to stakers
+func (p Params) IsRewardDenom(denom string) bool {
+	if len(p.RewardDenoms) == 0 {
+		return true
 	}
+
+	for _, d := range p.RewardDenoms {
+		if d == denom {
+			return true
+		}
+	}
+
+	return false
 }
 
 func (p Params) String() string {
@@ -56,6 +89,8 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		params.NewParamSetPair(ParamStoreKeyBaseProposerReward, &p.BaseProposerReward, validateBaseProposerReward),
 		params.NewParamSetPair(ParamStoreKeyBonusProposerReward, &p.BonusProposerReward, validateBonusProposerReward),
 		params.NewParamSetPair(ParamStoreKeyWithdrawAddrEnabled, &p.WithdrawAddrEnabled, validateWithdrawAddrEnabled),
+		params.NewParamSetPair(ParamStoreKeyRewardDenoms, &p.RewardDenoms, validateRewardDenoms),
+		params.NewParamSetPair(ParamStoreKeyNonRewardDenomsTo, &p.NonRewardDenomsTo, validateNonRewardDenomsTo),
 	}
 }
 
@@ -81,6 +116,12 @@ func (p Params) ValidateBasic() error {
 			"sum of base and bonus proposer reward cannot greater than one: %s", v,
 		)
 	}
+	if err := validateRewardDenoms(p.RewardDenoms); err != nil {
+		return err
+	}
+	if err := validateNonRewardDenomsTo(p.NonRewardDenomsTo); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -150,3 +191,38 @@ func validateWithdrawAddrEnabled(i interface{}) error {
 
 	return nil
 }
+
+func validateRewardDenoms(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, denom := range v {
+		if err := chainTypes.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid reward denom %s: %s", denom, err.Error())
+		}
+		if seen[denom] {
+			return fmt.Errorf("duplicate reward denom: %s", denom)
+		}
+		seen[denom] = true
+	}
+
+	return nil
+}
+
+func validateNonRewardDenomsTo(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	switch v {
+	case "", NonRewardDenomsToCommunityPool, NonRewardDenomsToBurn:
+		return nil
+	default:
+		return fmt.Errorf("non reward denoms destination must be %s or %s: %s",
+			NonRewardDenomsToCommunityPool, NonRewardDenomsToBurn, v)
+	}
+}
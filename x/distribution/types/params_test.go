@@ -35,3 +35,43 @@ func Test_validateAuxFuncs(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateRewardDenoms(t *testing.T) {
+	testCases := []struct {
+		name    string
+		arg     interface{}
+		wantErr bool
+	}{
+		{"wrong type", "kuchain/kcs", true},
+		{"empty", []string{}, false},
+		{"valid", []string{"kuchain/kcs", "foo/bar"}, false},
+		{"invalid denom", []string{"kuchain/kcs", "&&"}, true},
+		{"duplicate", []string{"kuchain/kcs", "kuchain/kcs"}, true},
+	}
+
+	for _, tc := range testCases {
+		stc := tc
+
+		t.Run(stc.name, func(t *testing.T) {
+			require.Equal(t, stc.wantErr, validateRewardDenoms(stc.arg) != nil)
+		})
+	}
+}
+
+func Test_validateNonRewardDenomsTo(t *testing.T) {
+	require.NoError(t, validateNonRewardDenomsTo(NonRewardDenomsToCommunityPool))
+	require.NoError(t, validateNonRewardDenomsTo(NonRewardDenomsToBurn))
+	require.NoError(t, validateNonRewardDenomsTo(""))
+	require.Error(t, validateNonRewardDenomsTo("validators"))
+	require.Error(t, validateNonRewardDenomsTo(1))
+}
+
+func TestParamsIsRewardDenom(t *testing.T) {
+	params := DefaultParams()
+	require.True(t, params.IsRewardDenom("kuchain/kcs"))
+	require.True(t, params.IsRewardDenom("foo/bar"))
+
+	params.RewardDenoms = []string{"kuchain/kcs"}
+	require.True(t, params.IsRewardDenom("kuchain/kcs"))
+	require.False(t, params.IsRewardDenom("foo/bar"))
+}
@@ -2,7 +2,10 @@ package types
 
 import (
 	"fmt"
+	"sort"
 	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // QueryDelegatorTotalRewardsResponse defines the properties of
@@ -42,6 +45,68 @@ func NewDelegationDelegatorReward(valAddr AccountID, reward DecCoins) Delegation
 	return DelegationDelegatorReward{ValidatorAddress: valAddr, Reward: reward}
 }
 
+// ValidatorDenomReward defines the reward in a denom from a validator.
+type ValidatorDenomReward struct {
+	ValidatorAddress AccountID `json:"validator_account" yaml:"validator_account"`
+	Amount           Dec       `json:"amount" yaml:"amount"`
+}
+
+// DelegatorDenomReward defines the pending rewards of a delegator in one denom.
+type DelegatorDenomReward struct {
+	Denom      string                 `json:"denom" yaml:"denom"`
+	Total      Dec                    `json:"total" yaml:"total"`
+	Validators []ValidatorDenomReward `json:"validators" yaml:"validators"`
+}
+
+// QueryDelegatorRewardsByDenomResponse defines the properties of
+// QueryDelegatorRewardsByDenom query's response.
+type QueryDelegatorRewardsByDenomResponse struct {
+	Rewards []DelegatorDenomReward `json:"rewards" yaml:"rewards"`
+}
+
+// NewQueryDelegatorRewardsByDenomResponse groups the delegation rewards by denom.
+func NewQueryDelegatorRewardsByDenomResponse(rewards []DelegationDelegatorReward) QueryDelegatorRewardsByDenomResponse {
+	res := QueryDelegatorRewardsByDenomResponse{Rewards: []DelegatorDenomReward{}}
+	idxs := make(map[string]int)
+
+	for _, reward := range rewards {
+		for _, coin := range reward.Reward {
+			idx, ok := idxs[coin.Denom]
+			if !ok {
+				idx = len(res.Rewards)
+				idxs[coin.Denom] = idx
+				res.Rewards = append(res.Rewards, DelegatorDenomReward{
+					Denom: coin.Denom,
+					Total: sdk.ZeroDec(),
+				})
+			}
+
+			res.Rewards[idx].Total = res.Rewards[idx].Total.Add(coin.Amount)
+			res.Rewards[idx].Validators = append(res.Rewards[idx].Validators, ValidatorDenomReward{
+				ValidatorAddress: reward.ValidatorAddress,
+				Amount:           coin.Amount,
+			})
+		}
+	}
+
+	sort.Slice(res.Rewards, func(i, j int) bool {
+		return res.Rewards[i].Denom < res.Rewards[j].Denom
+	})
+
+	return res
+}
+
+func (res QueryDelegatorRewardsByDenomResponse) String() string {
+	out := "Delegator Rewards By Denom:\n"
+	for _, reward := range res.Rewards {
+		out += fmt.Sprintf("  %s: %s\n", reward.Denom, reward.Total)
+		for _, v := range reward.Validators {
+			out += fmt.Sprintf("    ValidatorAddress: %s Reward: %s\n", v.ValidatorAddress, v.Amount)
+		}
+	}
+	return strings.TrimSpace(out)
+}
+
 type WithDrawAddrInfo struct {
 	WithDrawAddress  AccountID `json:"withdraw_account" yaml:"withdraw_account"`
 	ValidatorAddress AccountID `json:"validator_account" yaml:"validator_account"`
@@ -21,13 +21,22 @@ const (
 	AttributeValueCategory   = types.AttributeValueCategory
 	AttributeKeyEvidenceHash = types.AttributeKeyEvidenceHash
 	DefaultMaxEvidenceAge    = types.DefaultMaxEvidenceAge
+	RouteEquivocation        = types.RouteEquivocation
+	RouteLightClientAttack   = types.RouteLightClientAttack
+	RouteInvalidPluginReport = types.RouteInvalidPluginReport
 )
 
 var (
 	NewKeeper  = keeper.NewKeeper
 	NewQuerier = keeper.NewQuerier
 
+	LightClientAttackHandler   = keeper.LightClientAttackHandler
+	InvalidPluginReportHandler = keeper.InvalidPluginReportHandler
+
 	NewMsgSubmitEvidenceBase     = types.NewMsgSubmitEvidenceBase
+	NewMsgSubmitEvidence         = types.NewMsgSubmitEvidence
+	NewKuMsgSubmitEvidence       = types.NewKuMsgSubmitEvidence
+	PluginReportSignBytes        = types.PluginReportSignBytes
 	NewRouter                    = types.NewRouter
 	NewQueryEvidenceParams       = types.NewQueryEvidenceParams
 	NewQueryAllEvidenceParams    = types.NewQueryAllEvidenceParams
@@ -43,6 +52,11 @@ var (
 	ErrInvalidEvidence           = types.ErrInvalidEvidence
 	ErrNoEvidenceExists          = types.ErrNoEvidenceExists
 	ErrEvidenceExists            = types.ErrEvidenceExists
+	ErrEvidenceTooOld            = types.ErrEvidenceTooOld
+	ErrValidatorNotFound         = types.ErrValidatorNotFound
+	ErrValidatorTombstoned       = types.ErrValidatorTombstoned
+	ErrInvalidSignature          = types.ErrInvalidSignature
+	ErrCannotVerifyEvidence      = types.ErrCannotVerifyEvidence
 )
 
 type (
@@ -53,5 +67,10 @@ type (
 	Handler               = types.Handler
 	Router                = types.Router
 	Equivocation          = types.Equivocation
+	LightClientAttack     = types.LightClientAttack
+	InvalidPluginReport   = types.InvalidPluginReport
+	PluginReport          = types.PluginReport
+	MsgSubmitEvidence     = types.MsgSubmitEvidence
+	KuMsgSubmitEvidence   = types.KuMsgSubmitEvidence
 	Codec                 = types.Codec
 )
@@ -1,10 +1,19 @@
 package cli
 
 import (
+	"bufio"
+	"io/ioutil"
+	"strings"
+
 	"github.com/KuChainNetwork/kuchain/chain/client/flags"
+	"github.com/KuChainNetwork/kuchain/chain/client/txutil"
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/evidence/exported"
 	"github.com/KuChainNetwork/kuchain/x/evidence/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/spf13/cobra"
 )
 
@@ -27,18 +36,76 @@ func GetTxCmd(storeKey string, cdc *codec.Codec, childCmds []*cobra.Command) *co
 		submitEvidenceCmd.AddCommand(flags.PostCommands(childCmd)[0])
 	}
 
-	// TODO: Add tx commands.
+	cmd.AddCommand(flags.PostCommands(submitEvidenceCmd)...)
 
 	return cmd
 }
 
 // SubmitEvidenceCmd returns the top-level evidence submission command handler.
 // All concrete evidence submission child command handlers should be registered
-// under this command.
+// under this command. Used directly, it submits any evidence type registered in
+// the codec from a JSON file.
 func SubmitEvidenceCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "submit",
+		Use:   "submit [submitter] [evidence-file]",
 		Short: "Submit arbitrary evidence of misbehavior",
+		Long: strings.TrimSpace(`Submit evidence of misbehavior from a JSON file, the evidence is
+encoded with its type, for example conflicting plugin reports:
+
+$ <appcli> tx kuevidence submit jack ./evidence.json --from jack
+
+where evidence.json contains:
+
+{
+  "type": "kuchain/InvalidPluginReport",
+  "value": {
+    "height": "10",
+    ...
+  }
+}
+
+The power and time of the infraction are read from the chain history at its height.
+`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
+			cliCtx := txutil.NewKuCLICtxByBuf(cdc, inBuf)
+
+			submitter, err := chainTypes.NewAccountIDFromStr(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "submitter account id error")
+			}
+
+			bz, err := ioutil.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			var evidence exported.Evidence
+			if err := cdc.UnmarshalJSON(bz, &evidence); err != nil {
+				return sdkerrors.Wrap(err, "evidence file unmarshal error")
+			}
+
+			authAddress, err := txutil.QueryAccountAuth(cliCtx, submitter)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "query account %s auth error", submitter)
+			}
+
+			msg, err := types.NewKuMsgSubmitEvidence(authAddress, submitter, evidence)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			cliCtx = cliCtx.WithFromAccount(submitter)
+			if txBldr.FeePayer().Empty() {
+				txBldr = txBldr.WithPayer(args[0])
+			}
+			return txutil.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
 	}
 
 	return cmd
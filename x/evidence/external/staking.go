@@ -2,6 +2,9 @@ package external
 
 import (
 	"github.com/KuChainNetwork/kuchain/x/staking/exported"
+	stakingtypes "github.com/KuChainNetwork/kuchain/x/staking/types"
 )
 
 type StakingValidatorl = exported.ValidatorI
+
+type StakingHistoricalInfo = stakingtypes.HistoricalInfo
@@ -4,6 +4,7 @@ import (
 	"github.com/KuChainNetwork/kuchain/chain/msg"
 	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
 	"github.com/KuChainNetwork/kuchain/x/evidence/exported"
+	"github.com/KuChainNetwork/kuchain/x/evidence/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -11,6 +12,9 @@ import (
 func NewHandler(k Keeper) msg.Handler {
 	return func(ctx chainTypes.Context, msg sdk.Msg) (*sdk.Result, error) {
 		switch msg := msg.(type) {
+		case KuMsgSubmitEvidence:
+			return handleKuMsgSubmitEvidence(ctx, k, msg)
+
 		case MsgSubmitEvidenceBase:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%T must be extended to support evidence", msg)
 
@@ -25,8 +29,27 @@ func NewHandler(k Keeper) msg.Handler {
 	}
 }
 
+func handleKuMsgSubmitEvidence(ctx chainTypes.Context, k Keeper, msg KuMsgSubmitEvidence) (*sdk.Result, error) {
+	msgData := types.MsgSubmitEvidence{}
+	if err := msg.UnmarshalData(types.Cdc(), &msgData); err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg submit evidence data unmarshal error")
+	}
+
+	ctx.RequireAuth(msgData.GetSubmitter())
+
+	evidence := msgData.GetEvidence()
+	if evidence == nil {
+		return nil, sdkerrors.Wrap(ErrInvalidEvidence, "missing evidence")
+	}
+
+	return submitEvidence(ctx.Context(), k, evidence, msgData.GetSubmitter())
+}
+
 func handleMsgSubmitEvidence(ctx sdk.Context, k Keeper, msg exported.MsgSubmitEvidence) (*sdk.Result, error) {
-	evidence := msg.GetEvidence()
+	return submitEvidence(ctx, k, msg.GetEvidence(), msg.GetSubmitter())
+}
+
+func submitEvidence(ctx sdk.Context, k Keeper, evidence exported.Evidence, submitter chainTypes.AccountID) (*sdk.Result, error) {
 	if err := k.SubmitEvidence(ctx, evidence); err != nil {
 		return nil, err
 	}
@@ -35,7 +58,7 @@ func handleMsgSubmitEvidence(ctx sdk.Context, k Keeper, msg exported.MsgSubmitEv
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
-			sdk.NewAttribute(sdk.AttributeKeySender, msg.GetSubmitter().String()),
+			sdk.NewAttribute(sdk.AttributeKeySender, submitter.String()),
 		),
 	)
 
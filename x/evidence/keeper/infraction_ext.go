@@ -0,0 +1,254 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/KuChainNetwork/kuchain/x/evidence/exported"
+	"github.com/KuChainNetwork/kuchain/x/evidence/external"
+	"github.com/KuChainNetwork/kuchain/x/evidence/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// LightClientAttackHandler returns the evidence Handler for LightClientAttack evidence.
+func LightClientAttackHandler(k Keeper) types.Handler {
+	return func(ctx sdk.Context, evidence exported.Evidence) error {
+		switch e := evidence.(type) {
+		case types.LightClientAttack:
+			return k.HandleLightClientAttack(ctx, e)
+		case *types.LightClientAttack:
+			return k.HandleLightClientAttack(ctx, *e)
+		default:
+			return sdkerrors.Wrapf(types.ErrInvalidEvidence, "unexpected evidence type %T", evidence)
+		}
+	}
+}
+
+// InvalidPluginReportHandler returns the evidence Handler for InvalidPluginReport evidence.
+func InvalidPluginReportHandler(k Keeper) types.Handler {
+	return func(ctx sdk.Context, evidence exported.Evidence) error {
+		switch e := evidence.(type) {
+		case types.InvalidPluginReport:
+			return k.HandleInvalidPluginReport(ctx, e)
+		case *types.InvalidPluginReport:
+			return k.HandleInvalidPluginReport(ctx, *e)
+		default:
+			return sdkerrors.Wrapf(types.ErrInvalidEvidence, "unexpected evidence type %T", evidence)
+		}
+	}
+}
+
+// infraction is the chain state at the height of a submitted evidence.
+type infraction struct {
+	pubKey crypto.PubKey
+	power  int64
+	hist   external.StakingHistoricalInfo
+}
+
+// HandleLightClientAttack implements the LightClientAttack evidence handler. The
+// evidence is valid if the validator signed a commit for a header whose state
+// fields (last block, validators, consensus params, app state and results) differ
+// from the canonical header at the same height, and the commit is signed by more
+// than 1/3 of the validator set power, which a light client would trust. Honest
+// validators never sign such a header, so the validator is slashed, jailed and
+// tombstoned. A vote for another block with the canonical state, as happens in
+// rounds which never commit, is not a misbehaviour.
+//
+// The canonical header and validator set are read from the staking historical
+// info, so the evidence can only be verified while HistoricalEntries covers it.
+func (k Keeper) HandleLightClientAttack(ctx sdk.Context, evidence types.LightClientAttack) error {
+	inf, err := k.checkEvidenceValidator(ctx, evidence)
+	if err != nil {
+		return err
+	}
+
+	header := evidence.ConflictingBlock.Header
+	if header.ChainID != ctx.ChainID() {
+		return sdkerrors.Wrapf(types.ErrInvalidEvidence, "conflicting header of chain %s", header.ChainID)
+	}
+	if !conflictsWithState(inf.hist.Header, header) {
+		return sdkerrors.Wrap(types.ErrInvalidEvidence, "conflicting header does not conflict with the canonical state")
+	}
+
+	if err := verifyConflictingCommit(ctx.ChainID(), inf.hist, evidence); err != nil {
+		return err
+	}
+
+	k.punishValidator(ctx, evidence, inf.power,
+		k.SlashFractionLightClientAttack(ctx), k.LightClientAttackJailDuration(ctx), true)
+
+	return nil
+}
+
+// HandleInvalidPluginReport implements the InvalidPluginReport evidence handler.
+// The evidence is valid if the validator signed two different reports for the
+// same plugin and height. The validator is then slashed and jailed.
+func (k Keeper) HandleInvalidPluginReport(ctx sdk.Context, evidence types.InvalidPluginReport) error {
+	inf, err := k.checkEvidenceValidator(ctx, evidence)
+	if err != nil {
+		return err
+	}
+
+	for _, report := range []types.PluginReport{evidence.ReportA, evidence.ReportB} {
+		signBytes := types.PluginReportSignBytes(ctx.ChainID(), evidence.Plugin, evidence.GetHeight(), report.ReportHash)
+		if !inf.pubKey.VerifyBytes(signBytes, report.Signature) {
+			return sdkerrors.Wrapf(types.ErrInvalidSignature, "report %s", report.ReportHash)
+		}
+	}
+
+	k.punishValidator(ctx, evidence, inf.power,
+		k.SlashFractionInvalidPluginReport(ctx), k.InvalidPluginReportJailDuration(ctx), false)
+
+	return nil
+}
+
+// checkEvidenceValidator checks the infraction height is in the evidence window and
+// its validator can still be punished. The infraction time and the validator power
+// are read from the historical info at the infraction height, never from the evidence.
+func (k Keeper) checkEvidenceValidator(ctx sdk.Context, evidence exported.Evidence) (infraction, error) {
+	consAddr := evidence.GetConsensusAddress()
+
+	height := evidence.GetHeight()
+	if height <= 0 || height > ctx.BlockHeight() {
+		return infraction{}, sdkerrors.Wrapf(types.ErrInvalidEvidence, "infraction height %d out of range", height)
+	}
+
+	hist, found := k.stakingKeeper.GetHistoricalInfo(ctx, height)
+	if !found {
+		return infraction{}, sdkerrors.Wrapf(types.ErrCannotVerifyEvidence, "no historical info for height %d", height)
+	}
+
+	age := ctx.BlockHeader().Time.Sub(hist.Header.Time)
+	if age > k.MaxEvidenceAge(ctx) || age > k.stakingKeeper.UnbondingTime(ctx) {
+		return infraction{}, sdkerrors.Wrapf(types.ErrEvidenceTooOld, "age %s past max age %s", age, k.MaxEvidenceAge(ctx))
+	}
+
+	power := int64(-1)
+	for _, val := range hist.Valset {
+		if val.GetConsAddr().Equals(consAddr) {
+			power = val.ConsensusPower()
+			break
+		}
+	}
+	if power < 0 {
+		return infraction{}, sdkerrors.Wrapf(types.ErrValidatorNotFound, "%s not in validator set at height %d", consAddr, height)
+	}
+
+	pubKey, err := k.slashingKeeper.GetPubkey(ctx, consAddr.Bytes())
+	if err != nil {
+		return infraction{}, sdkerrors.Wrap(types.ErrValidatorNotFound, consAddr.String())
+	}
+
+	validator := k.stakingKeeper.ValidatorByConsAddr(ctx, consAddr)
+	if validator == nil || validator.IsUnbonded() {
+		return infraction{}, sdkerrors.Wrap(types.ErrValidatorNotFound, consAddr.String())
+	}
+
+	if !k.slashingKeeper.HasValidatorSigningInfo(ctx, consAddr) {
+		return infraction{}, sdkerrors.Wrapf(types.ErrValidatorNotFound, "no signing info for %s", consAddr)
+	}
+
+	if k.slashingKeeper.IsTombstoned(ctx, consAddr) {
+		return infraction{}, sdkerrors.Wrap(types.ErrValidatorTombstoned, consAddr.String())
+	}
+
+	return infraction{
+		pubKey: pubKey,
+		power:  power,
+		hist:   hist,
+	}, nil
+}
+
+// conflictsWithState returns true if the header differs from the canonical one in
+// the fields determined by the chain state rather than by the proposer.
+func conflictsWithState(canonical abci.Header, header *tmtypes.Header) bool {
+	return !bytes.Equal(canonical.LastBlockId.Hash, header.LastBlockID.Hash) ||
+		!bytes.Equal(canonical.ValidatorsHash, header.ValidatorsHash) ||
+		!bytes.Equal(canonical.NextValidatorsHash, header.NextValidatorsHash) ||
+		!bytes.Equal(canonical.ConsensusHash, header.ConsensusHash) ||
+		!bytes.Equal(canonical.AppHash, header.AppHash) ||
+		!bytes.Equal(canonical.LastResultsHash, header.LastResultsHash)
+}
+
+// verifyConflictingCommit verifies the signatures in the conflicting commit by the
+// historical validator set, the evidence validator must have signed it and the
+// signers must have more than 1/3 of the total power.
+func verifyConflictingCommit(chainID string, hist external.StakingHistoricalInfo, evidence types.LightClientAttack) error {
+	commit := evidence.ConflictingBlock.Commit
+
+	totalPower := int64(0)
+	validators := make(map[string]int, len(hist.Valset))
+	for i, val := range hist.Valset {
+		validators[string(val.GetConsAddr())] = i
+		totalPower += val.ConsensusPower()
+	}
+
+	signedPower := int64(0)
+	signedByValidator := false
+	for idx, sig := range commit.Signatures {
+		if !sig.ForBlock() {
+			continue
+		}
+
+		i, ok := validators[string(sig.ValidatorAddress)]
+		if !ok {
+			continue
+		}
+		delete(validators, string(sig.ValidatorAddress))
+
+		val := hist.Valset[i]
+		if !val.GetConsPubKey().VerifyBytes(commit.VoteSignBytes(chainID, idx), sig.Signature) {
+			return sdkerrors.Wrapf(types.ErrInvalidSignature, "commit signature of %s", val.GetConsAddr())
+		}
+
+		signedPower += val.ConsensusPower()
+		if bytes.Equal(sig.ValidatorAddress, evidence.ConsensusAddress) {
+			signedByValidator = true
+		}
+	}
+
+	if !signedByValidator {
+		return sdkerrors.Wrapf(types.ErrInvalidSignature, "commit not signed by %s", evidence.ConsensusAddress)
+	}
+	if signedPower*3 <= totalPower {
+		return sdkerrors.Wrapf(types.ErrInvalidEvidence, "commit signed by power %d of %d", signedPower, totalPower)
+	}
+
+	return nil
+}
+
+// punishValidator slashes and jails the validator of a verified evidence by its
+// power at the infraction height, and tombstones it if required.
+func (k Keeper) punishValidator(ctx sdk.Context, evidence exported.Evidence, power int64, fraction sdk.Dec, jailDuration time.Duration, tombstone bool) {
+	consAddr := evidence.GetConsensusAddress()
+
+	k.Logger(ctx).Info(fmt.Sprintf("confirmed %s from %s at height %d", evidence.Type(), consAddr, evidence.GetHeight()))
+
+	distributionHeight := evidence.GetHeight() - sdk.ValidatorUpdateDelay
+	k.slashingKeeper.Slash(ctx, consAddr, fraction, power, distributionHeight)
+
+	validator := k.stakingKeeper.ValidatorByConsAddr(ctx, consAddr)
+	if validator != nil && !validator.IsJailed() {
+		k.slashingKeeper.Jail(ctx, consAddr)
+	}
+
+	k.slashingKeeper.JailUntil(ctx, consAddr, ctx.BlockHeader().Time.Add(jailDuration))
+
+	if tombstone {
+		k.slashingKeeper.Tombstone(ctx, consAddr)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlash,
+			sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
+			sdk.NewAttribute(types.AttributeKeyReason, evidence.Type()),
+		),
+	)
+}
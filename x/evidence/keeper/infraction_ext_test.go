@@ -0,0 +1,245 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/KuChainNetwork/kuchain/chain/config"
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	evidenceTypes "github.com/KuChainNetwork/kuchain/x/evidence/types"
+	stakingexport "github.com/KuChainNetwork/kuchain/x/staking/exported"
+	stakingTypes "github.com/KuChainNetwork/kuchain/x/staking/types"
+)
+
+func TestInit(t *testing.T) {
+	config.SealChainConfig()
+}
+
+func newTestApp(wallet *simapp.Wallet) (sdk.AccAddress, types.AccountID, *simapp.SimApp) {
+	addAlice := wallet.NewAccAddress()
+	accAlice := types.MustAccountID("alice@ok")
+
+	resInt, _ := sdk.NewIntFromString("100000000000000000000000")
+	genAlice := simapp.NewSimGenesisAccount(accAlice, addAlice).
+		WithAsset(types.NewCoins(types.NewCoin(constants.DefaultBondDenom, resInt)))
+
+	app := simapp.SetupWithGenesisAccounts(simapp.NewGenesisAccounts(wallet.GetRootAuth(), genAlice))
+	return addAlice, accAlice, app
+}
+
+func deliverMsg(t *testing.T, wallet *simapp.Wallet, app *simapp.SimApp, auth sdk.AccAddress, payer types.AccountID, msg sdk.Msg) {
+	ctxCheck := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1})
+	origAuthSeq, origAuthNum, err := app.AccountKeeper().GetAuthSequence(ctxCheck, auth)
+	So(err, ShouldBeNil)
+
+	fee := types.Coins{types.NewInt64Coin(constants.DefaultBondDenom, 1000000)}
+	header := abci.Header{Height: app.LastBlockHeight() + 1, Time: time.Now()}
+	_, _, err = simapp.SignCheckDeliver(t, app.Codec(), app.BaseApp,
+		header, payer, fee,
+		[]sdk.Msg{msg}, []uint64{origAuthNum}, []uint64{origAuthSeq},
+		true, true, wallet.PrivKey(auth))
+	So(err, ShouldBeNil)
+}
+
+// setupValidator creates a bonded validator with the consensus key, and returns the height
+// of the last committed block, which has the validator in its historical info.
+func setupValidator(t *testing.T, wallet *simapp.Wallet, app *simapp.SimApp, auth sdk.AccAddress, acc types.AccountID, consKey crypto.PrivKey) int64 {
+	description := stakingTypes.NewDescription("moniker", "identity", "website", "securityContact", "details")
+	deliverMsg(t, wallet, app, auth, acc,
+		stakingTypes.NewKuMsgCreateValidator(auth, acc, consKey.PubKey(), description, sdk.NewDecWithPrec(1, 1), acc))
+	deliverMsg(t, wallet, app, auth, acc,
+		stakingTypes.NewKuMsgDelegate(auth, acc, acc, types.NewInt64Coin(constants.DefaultBondDenom, 2100000000000000000)))
+	simapp.AfterBlockCommitted(app, 2)
+
+	return app.LastBlockHeight()
+}
+
+func signPluginReport(consKey crypto.PrivKey, height int64, reportHash []byte) evidenceTypes.PluginReport {
+	sig, err := consKey.Sign(evidenceTypes.PluginReportSignBytes("", "oracle", height, reportHash))
+	So(err, ShouldBeNil)
+	return evidenceTypes.PluginReport{ReportHash: reportHash, Signature: sig}
+}
+
+func signConflictingBlock(consKey crypto.PrivKey, header *tmtypes.Header) *tmtypes.SignedHeader {
+	blockID := tmtypes.BlockID{
+		Hash:        header.Hash(),
+		PartsHeader: tmtypes.PartSetHeader{Total: 1, Hash: tmhash.Sum([]byte("parts"))},
+	}
+	commit := tmtypes.NewCommit(header.Height, 0, blockID, []tmtypes.CommitSig{
+		tmtypes.NewCommitSigForBlock(make([]byte, 64), consKey.PubKey().Address(), header.Time),
+	})
+	sig, err := consKey.Sign(commit.VoteSignBytes("", 0))
+	So(err, ShouldBeNil)
+	commit.Signatures[0].Signature = sig
+
+	return &tmtypes.SignedHeader{Header: header, Commit: commit}
+}
+
+func TestHandleInvalidPluginReport(t *testing.T) {
+	wallet := simapp.NewWallet()
+
+	Convey("test handle invalid plugin report", t, func() {
+		auth, acc, app := newTestApp(wallet)
+		consKey := ed25519.GenPrivKey()
+		consAddr := sdk.ConsAddress(consKey.PubKey().Address())
+		height := setupValidator(t, wallet, app, auth, acc, consKey)
+
+		k := app.EvidenceKeeper()
+		newCtx := func(blockTime time.Time) sdk.Context {
+			ctx, _ := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1, Time: blockTime}).CacheContext()
+			return ctx
+		}
+
+		ctx := newCtx(time.Now())
+		hist, found := app.StakeKeeper().GetHistoricalInfo(ctx, height)
+		So(found, ShouldBeTrue)
+
+		evidence := evidenceTypes.InvalidPluginReport{
+			Height:           height,
+			ConsensusAddress: consAddr,
+			Plugin:           "oracle",
+			ReportA:          signPluginReport(consKey, height, []byte{1}),
+			ReportB:          signPluginReport(consKey, height, []byte{2}),
+		}
+		So(evidence.ValidateBasic(), ShouldBeNil)
+
+		Convey("future height is rejected", func() {
+			future := evidence
+			future.Height = app.LastBlockHeight() + 10
+			future.ReportA = signPluginReport(consKey, future.Height, []byte{1})
+			future.ReportB = signPluginReport(consKey, future.Height, []byte{2})
+
+			err := k.HandleInvalidPluginReport(newCtx(hist.Header.Time), future)
+			So(err, simapp.ShouldErrIs, evidenceTypes.ErrInvalidEvidence)
+		})
+
+		Convey("evidence older than max age is rejected", func() {
+			ctx := newCtx(hist.Header.Time.Add(k.MaxEvidenceAge(ctx) + time.Second))
+			err := k.HandleInvalidPluginReport(ctx, evidence)
+			So(err, simapp.ShouldErrIs, evidenceTypes.ErrEvidenceTooOld)
+		})
+
+		Convey("report not signed by the validator is rejected", func() {
+			forged := evidence
+			forged.ReportB = signPluginReport(ed25519.GenPrivKey(), height, []byte{2})
+
+			err := k.HandleInvalidPluginReport(newCtx(hist.Header.Time), forged)
+			So(err, simapp.ShouldErrIs, evidenceTypes.ErrInvalidSignature)
+		})
+
+		Convey("validator is slashed by its power at the infraction height", func() {
+			ctx := newCtx(hist.Header.Time.Add(time.Second))
+			before, found := app.StakeKeeper().GetValidatorByConsAddr(ctx, consAddr)
+			So(found, ShouldBeTrue)
+
+			So(k.SubmitEvidence(ctx, evidence), ShouldBeNil)
+
+			power := int64(0)
+			for _, val := range hist.Valset {
+				if val.GetConsAddr().Equals(consAddr) {
+					power = val.ConsensusPower()
+				}
+			}
+			slashed := stakingexport.TokensFromConsensusPower(power).ToDec().
+				Mul(k.SlashFractionInvalidPluginReport(ctx)).TruncateInt()
+
+			after, _ := app.StakeKeeper().GetValidatorByConsAddr(ctx, consAddr)
+			So(after.GetTokens(), ShouldResemble, before.GetTokens().Sub(slashed))
+			So(after.IsJailed(), ShouldBeTrue)
+			So(app.SlashKeeper().IsTombstoned(ctx, consAddr), ShouldBeFalse)
+
+			// the same reports cannot be submitted again
+			swapped := evidence
+			swapped.ReportA, swapped.ReportB = evidence.ReportB, evidence.ReportA
+			So(k.SubmitEvidence(ctx, swapped), simapp.ShouldErrIs, evidenceTypes.ErrEvidenceExists)
+		})
+	})
+}
+
+func TestHandleLightClientAttack(t *testing.T) {
+	wallet := simapp.NewWallet()
+
+	Convey("test handle light client attack", t, func() {
+		auth, acc, app := newTestApp(wallet)
+		consKey := ed25519.GenPrivKey()
+		consAddr := sdk.ConsAddress(consKey.PubKey().Address())
+		height := setupValidator(t, wallet, app, auth, acc, consKey)
+
+		k := app.EvidenceKeeper()
+		ctx, _ := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1, Time: time.Now()}).CacheContext()
+
+		// the canonical header with its state fields
+		hist, found := app.StakeKeeper().GetHistoricalInfo(ctx, height)
+		So(found, ShouldBeTrue)
+		hist.Header.LastBlockId.Hash = tmhash.Sum([]byte("last block"))
+		hist.Header.ValidatorsHash = tmhash.Sum([]byte("validators"))
+		hist.Header.NextValidatorsHash = tmhash.Sum([]byte("validators"))
+		hist.Header.AppHash = tmhash.Sum([]byte("app state"))
+		app.StakeKeeper().SetHistoricalInfo(ctx, height, hist)
+		ctx = ctx.WithBlockTime(hist.Header.Time.Add(time.Second))
+
+		newHeader := func(appHash []byte) *tmtypes.Header {
+			return &tmtypes.Header{
+				Height:             height,
+				Time:               hist.Header.Time.Add(time.Millisecond),
+				LastBlockID:        tmtypes.BlockID{Hash: hist.Header.LastBlockId.Hash},
+				DataHash:           tmhash.Sum([]byte("other txs")),
+				ValidatorsHash:     hist.Header.ValidatorsHash,
+				NextValidatorsHash: hist.Header.NextValidatorsHash,
+				AppHash:            appHash,
+				ProposerAddress:    consKey.PubKey().Address(),
+			}
+		}
+
+		Convey("commit for another block with the canonical state is not an attack", func() {
+			evidence := evidenceTypes.LightClientAttack{
+				ConsensusAddress: consAddr,
+				ConflictingBlock: signConflictingBlock(consKey, newHeader(hist.Header.AppHash)),
+			}
+			So(evidence.ValidateBasic(), ShouldBeNil)
+
+			err := k.HandleLightClientAttack(ctx, evidence)
+			So(err, simapp.ShouldErrIs, evidenceTypes.ErrInvalidEvidence)
+			So(app.SlashKeeper().IsTombstoned(ctx, consAddr), ShouldBeFalse)
+		})
+
+		Convey("commit with a forged signature is rejected", func() {
+			evidence := evidenceTypes.LightClientAttack{
+				ConsensusAddress: consAddr,
+				ConflictingBlock: signConflictingBlock(consKey, newHeader(tmhash.Sum([]byte("lunatic state")))),
+			}
+			sig, err := ed25519.GenPrivKey().Sign(evidence.ConflictingBlock.Commit.VoteSignBytes("", 0))
+			So(err, ShouldBeNil)
+			evidence.ConflictingBlock.Commit.Signatures[0].Signature = sig
+
+			err = k.HandleLightClientAttack(ctx, evidence)
+			So(err, simapp.ShouldErrIs, evidenceTypes.ErrInvalidSignature)
+		})
+
+		Convey("commit for a header conflicting with the state is an attack", func() {
+			evidence := evidenceTypes.LightClientAttack{
+				ConsensusAddress: consAddr,
+				ConflictingBlock: signConflictingBlock(consKey, newHeader(tmhash.Sum([]byte("lunatic state")))),
+			}
+			So(evidence.ValidateBasic(), ShouldBeNil)
+
+			before, _ := app.StakeKeeper().GetValidatorByConsAddr(ctx, consAddr)
+			So(k.HandleLightClientAttack(ctx, evidence), ShouldBeNil)
+
+			after, _ := app.StakeKeeper().GetValidatorByConsAddr(ctx, consAddr)
+			So(after.GetTokens().LT(before.GetTokens()), ShouldBeTrue)
+			So(after.IsJailed(), ShouldBeTrue)
+			So(app.SlashKeeper().IsTombstoned(ctx, consAddr), ShouldBeTrue)
+		})
+	})
+}
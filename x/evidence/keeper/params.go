@@ -19,6 +19,30 @@ func (k Keeper) DoubleSignJailDuration(ctx sdk.Context) (res time.Duration) {
 	return
 }
 
+// SlashFractionLightClientAttack returns the fraction slashed for a light client attack.
+func (k Keeper) SlashFractionLightClientAttack(ctx sdk.Context) (res sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeySlashFractionLightClientAttack, &res)
+	return
+}
+
+// LightClientAttackJailDuration returns the jail duration for a light client attack.
+func (k Keeper) LightClientAttackJailDuration(ctx sdk.Context) (res time.Duration) {
+	k.paramSpace.Get(ctx, types.KeyLightClientAttackJailDuration, &res)
+	return
+}
+
+// SlashFractionInvalidPluginReport returns the fraction slashed for conflicting plugin reports.
+func (k Keeper) SlashFractionInvalidPluginReport(ctx sdk.Context) (res sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeySlashFractionInvalidPluginReport, &res)
+	return
+}
+
+// InvalidPluginReportJailDuration returns the jail duration for conflicting plugin reports.
+func (k Keeper) InvalidPluginReportJailDuration(ctx sdk.Context) (res time.Duration) {
+	k.paramSpace.Get(ctx, types.KeyInvalidPluginReportJailDuration, &res)
+	return
+}
+
 // GetParams returns the total set of evidence parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
@@ -67,6 +67,16 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterInterface((*exported.Evidence)(nil), nil)
 	cdc.RegisterConcrete(MsgSubmitEvidenceBase{}, "kuchain/MsgSubmitEvidenceBase", nil)
 	cdc.RegisterConcrete(Equivocation{}, "kuchain/Equivocation", nil)
+	cdc.RegisterConcrete(LightClientAttack{}, "kuchain/LightClientAttack", nil)
+	cdc.RegisterConcrete(InvalidPluginReport{}, "kuchain/InvalidPluginReport", nil)
+
+	cdc.RegisterInterface((*isEvidence_Sum)(nil), nil)
+	cdc.RegisterConcrete(&EvidenceEquivocation{}, "kuchain/EvidenceEquivocation", nil)
+	cdc.RegisterConcrete(&EvidenceLightClientAttack{}, "kuchain/EvidenceLightClientAttack", nil)
+	cdc.RegisterConcrete(&EvidenceInvalidPluginReport{}, "kuchain/EvidenceInvalidPluginReport", nil)
+
+	cdc.RegisterConcrete(&MsgSubmitEvidence{}, "kuchain/MsgSubmitEvidence", nil)
+	cdc.RegisterConcrete(KuMsgSubmitEvidence{}, "kuchain/KuMsgSubmitEvidence", nil)
 }
 
 var (
@@ -81,6 +91,11 @@ var (
 	Evidence_Cdc = NewEveidenceCodec(ModuleCdc)
 )
 
+// Cdc get codec for types
+func Cdc() *codec.Codec {
+	return ModuleCdc
+}
+
 func init() {
 	RegisterCodec(ModuleCdc)
 	codec.RegisterCrypto(ModuleCdc)
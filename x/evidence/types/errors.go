@@ -11,4 +11,9 @@ var (
 	ErrInvalidEvidence         = sdkerrors.Register(ModuleName, 3, "invalid evidence")
 	ErrNoEvidenceExists        = sdkerrors.Register(ModuleName, 4, "evidence does not exist")
 	ErrEvidenceExists          = sdkerrors.Register(ModuleName, 5, "evidence already exists")
+	ErrEvidenceTooOld          = sdkerrors.Register(ModuleName, 6, "evidence is too old")
+	ErrValidatorNotFound       = sdkerrors.Register(ModuleName, 7, "validator not found or unbonded")
+	ErrValidatorTombstoned     = sdkerrors.Register(ModuleName, 8, "validator already tombstoned")
+	ErrInvalidSignature        = sdkerrors.Register(ModuleName, 9, "invalid evidence signature")
+	ErrCannotVerifyEvidence    = sdkerrors.Register(ModuleName, 10, "evidence cannot be verified against chain history")
 )
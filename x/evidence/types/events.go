@@ -3,7 +3,11 @@ package types
 // evidence module events
 const (
 	EventTypeSubmitEvidence = "submit_evidence"
+	EventTypeSlash          = "slash"
 
 	AttributeValueCategory   = "evidence"
 	AttributeKeyEvidenceHash = "evidence_hash"
+	AttributeKeyAddress      = "address"
+	AttributeKeyPower        = "power"
+	AttributeKeyReason       = "reason"
 )
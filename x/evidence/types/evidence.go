@@ -102,13 +102,30 @@ func ConvertDuplicateVoteEvidence(dupVote abci.Evidence) exported.Evidence {
 // Evidence defines the application-level allowed Evidence to be submitted via a
 // MsgSubmitEvidence message.
 type Evidence struct {
-	Sum interface{} `protobuf_oneof:"sum"`
+	Sum isEvidence_Sum `protobuf_oneof:"sum"`
+}
+
+// isEvidence_Sum is implemented by the wrappers of each allowed Evidence type.
+type isEvidence_Sum interface {
+	isEvidence_Sum()
 }
 
 type EvidenceEquivocation struct {
 	Equivocation *Equivocation `json:"equivocation,omitempty" yaml:"equivocation"`
 }
 
+type EvidenceLightClientAttack struct {
+	LightClientAttack *LightClientAttack `json:"light_client_attack,omitempty" yaml:"light_client_attack"`
+}
+
+type EvidenceInvalidPluginReport struct {
+	InvalidPluginReport *InvalidPluginReport `json:"invalid_plugin_report,omitempty" yaml:"invalid_plugin_report"`
+}
+
+func (*EvidenceEquivocation) isEvidence_Sum()        {}
+func (*EvidenceLightClientAttack) isEvidence_Sum()   {}
+func (*EvidenceInvalidPluginReport) isEvidence_Sum() {}
+
 func (m *Evidence) GetEquivocation() *Equivocation {
 	if x, ok := m.Sum.(*EvidenceEquivocation); ok {
 		return x.Equivocation
@@ -116,9 +133,29 @@ func (m *Evidence) GetEquivocation() *Equivocation {
 	return nil
 }
 
+func (m *Evidence) GetLightClientAttack() *LightClientAttack {
+	if x, ok := m.Sum.(*EvidenceLightClientAttack); ok {
+		return x.LightClientAttack
+	}
+	return nil
+}
+
+func (m *Evidence) GetInvalidPluginReport() *InvalidPluginReport {
+	if x, ok := m.Sum.(*EvidenceInvalidPluginReport); ok {
+		return x.InvalidPluginReport
+	}
+	return nil
+}
+
 func (this *Evidence) GetEvidence() exported.Evidence {
 	if x := this.GetEquivocation(); x != nil {
-		return x
+		return *x
+	}
+	if x := this.GetLightClientAttack(); x != nil {
+		return *x
+	}
+	if x := this.GetInvalidPluginReport(); x != nil {
+		return *x
 	}
 	return nil
 }
@@ -135,6 +172,18 @@ func (e *Evidence) SetEvidence(value exported.Evidence) error {
 	case Equivocation:
 		e.Sum = &EvidenceEquivocation{&vt}
 		return nil
+	case *LightClientAttack:
+		e.Sum = &EvidenceLightClientAttack{vt}
+		return nil
+	case LightClientAttack:
+		e.Sum = &EvidenceLightClientAttack{&vt}
+		return nil
+	case *InvalidPluginReport:
+		e.Sum = &EvidenceInvalidPluginReport{vt}
+		return nil
+	case InvalidPluginReport:
+		e.Sum = &EvidenceInvalidPluginReport{&vt}
+		return nil
 	}
 	return fmt.Errorf("can't encode value of type %T as message Evidence", value)
 }
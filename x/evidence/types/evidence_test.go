@@ -0,0 +1,100 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+func TestEvidenceCodec(t *testing.T) {
+	Convey("TestEvidenceCodec", t, func() {
+		now := time.Now().UTC()
+		consAddr := sdk.ConsAddress([]byte("consensus address 01"))
+
+		equivocation := Equivocation{Height: 10, Time: now, Power: 100, ConsensusAddress: consAddr}
+		bz, err := Evidence_Cdc.MarshalEvidence(equivocation)
+		So(err, ShouldBeNil)
+		got, err := Evidence_Cdc.UnmarshalEvidence(bz)
+		So(err, ShouldBeNil)
+		So(got.Hash(), ShouldResemble, equivocation.Hash())
+
+		report := InvalidPluginReport{
+			Height: 10, ConsensusAddress: consAddr, Plugin: "oracle",
+			ReportA: PluginReport{ReportHash: []byte{1}, Signature: []byte{1}},
+			ReportB: PluginReport{ReportHash: []byte{2}, Signature: []byte{2}},
+		}
+		So(report.ValidateBasic(), ShouldBeNil)
+
+		bz, err = Evidence_Cdc.MarshalEvidence(report)
+		So(err, ShouldBeNil)
+		got, err = Evidence_Cdc.UnmarshalEvidence(bz)
+		So(err, ShouldBeNil)
+		So(got.Route(), ShouldEqual, RouteInvalidPluginReport)
+		So(got.Hash(), ShouldResemble, report.Hash())
+
+		// the hash only covers the signed reports, in any order
+		swapped := report
+		swapped.ReportA, swapped.ReportB = report.ReportB, report.ReportA
+		So(swapped.Hash(), ShouldResemble, report.Hash())
+
+		report.ReportB.ReportHash = report.ReportA.ReportHash
+		So(report.ValidateBasic(), ShouldNotBeNil)
+	})
+}
+
+func TestLightClientAttackValidateBasic(t *testing.T) {
+	Convey("TestLightClientAttackValidateBasic", t, func() {
+		privKey := ed25519.GenPrivKey()
+		consAddr := sdk.ConsAddress(privKey.PubKey().Address())
+		now := time.Now().UTC()
+
+		header := &tmtypes.Header{
+			ChainID:         "test-chain",
+			Height:          10,
+			Time:            now,
+			ValidatorsHash:  tmhash.Sum([]byte("validators")),
+			AppHash:         tmhash.Sum([]byte("conflicting app hash")),
+			ProposerAddress: privKey.PubKey().Address(),
+		}
+		blockID := tmtypes.BlockID{
+			Hash:        header.Hash(),
+			PartsHeader: tmtypes.PartSetHeader{Total: 1, Hash: make([]byte, 32)},
+		}
+		commit := tmtypes.NewCommit(10, 0, blockID, []tmtypes.CommitSig{
+			tmtypes.NewCommitSigForBlock(make([]byte, 64), privKey.PubKey().Address(), now),
+		})
+		sig, err := privKey.Sign(commit.VoteSignBytes("test-chain", 0))
+		So(err, ShouldBeNil)
+		commit.Signatures[0].Signature = sig
+
+		attack := LightClientAttack{
+			ConsensusAddress: consAddr,
+			ConflictingBlock: &tmtypes.SignedHeader{Header: header, Commit: commit},
+		}
+		So(attack.ValidateBasic(), ShouldBeNil)
+		So(attack.GetHeight(), ShouldEqual, 10)
+
+		bz, err := Evidence_Cdc.MarshalEvidence(attack)
+		So(err, ShouldBeNil)
+		got, err := Evidence_Cdc.UnmarshalEvidence(bz)
+		So(err, ShouldBeNil)
+		So(got.Hash(), ShouldResemble, attack.Hash())
+
+		// the commit must be signed by the validator
+		other := attack
+		other.ConsensusAddress = sdk.ConsAddress(ed25519.GenPrivKey().PubKey().Address())
+		So(other.ValidateBasic(), ShouldNotBeNil)
+
+		// the commit must be for the conflicting header
+		changed := *header
+		changed.AppHash = tmhash.Sum([]byte("another app hash"))
+		other = attack
+		other.ConflictingBlock = &tmtypes.SignedHeader{Header: &changed, Commit: commit}
+		So(other.ValidateBasic(), ShouldNotBeNil)
+	})
+}
@@ -13,6 +13,8 @@ type (
 	// evidence module.
 	StakingKeeper interface {
 		ValidatorByConsAddr(sdk.Context, sdk.ConsAddress) external.StakingValidatorl
+		GetHistoricalInfo(sdk.Context, int64) (external.StakingHistoricalInfo, bool)
+		UnbondingTime(sdk.Context) time.Duration
 	}
 
 	// SlashingKeeper defines the slashing module interface contract needed by the
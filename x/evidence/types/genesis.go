@@ -55,5 +55,18 @@ func (gs GenesisState) Validate() error {
 		return fmt.Errorf("max evidence age must be at least 1 minute, is %s", maxEvidence.String())
 	}
 
+	if err := validateSlashFraction(gs.Params.SlashFractionLightClientAttack); err != nil {
+		return err
+	}
+	if err := validateJailDuration(gs.Params.LightClientAttackJailDuration); err != nil {
+		return err
+	}
+	if err := validateSlashFraction(gs.Params.SlashFractionInvalidPluginReport); err != nil {
+		return err
+	}
+	if err := validateJailDuration(gs.Params.InvalidPluginReportJailDuration); err != nil {
+		return err
+	}
+
 	return nil
 }
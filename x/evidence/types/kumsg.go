@@ -0,0 +1,44 @@
+package types
+
+import (
+	"github.com/KuChainNetwork/kuchain/chain/msg"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/evidence/exported"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	RouterKeyName = types.MustName(RouterKey)
+)
+
+// KuMsgSubmitEvidence is the KuMsg carrying a MsgSubmitEvidence
+type KuMsgSubmitEvidence struct {
+	types.KuMsg
+}
+
+// NewKuMsgSubmitEvidence creates a new KuMsgSubmitEvidence instance
+func NewKuMsgSubmitEvidence(auth sdk.AccAddress, submitter types.AccountID, evidence exported.Evidence) (KuMsgSubmitEvidence, error) {
+	msgData, err := NewMsgSubmitEvidence(submitter, evidence)
+	if err != nil {
+		return KuMsgSubmitEvidence{}, err
+	}
+
+	return KuMsgSubmitEvidence{
+		*msg.MustNewKuMsg(
+			RouterKeyName,
+			msg.WithAuth(auth),
+			msg.WithData(Cdc(), &msgData),
+		),
+	}, nil
+}
+
+func (msg KuMsgSubmitEvidence) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateTransfer(); err != nil {
+		return err
+	}
+	msgData := MsgSubmitEvidence{}
+	if err := msg.UnmarshalData(Cdc(), &msgData); err != nil {
+		return err
+	}
+	return msgData.ValidateBasic()
+}
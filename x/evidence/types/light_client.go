@@ -0,0 +1,121 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/x/evidence/exported"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"gopkg.in/yaml.v2"
+)
+
+// Evidence type constants
+const (
+	RouteLightClientAttack = "lightclientattack"
+	TypeLightClientAttack  = "light_client_attack"
+)
+
+var _ exported.Evidence = (*LightClientAttack)(nil)
+
+// LightClientAttack implements the Evidence interface and defines evidence of a
+// validator signing a commit for a header which conflicts with the state of the
+// canonical chain at the same height, as used to fool light clients.
+//
+// The evidence only carries signed material, the power of the validator and the
+// time of the infraction are read from the chain history when it is handled.
+type LightClientAttack struct {
+	ConsensusAddress sdk.ConsAddress `json:"consensus_address,omitempty" yaml:"consensus_address"`
+	// ConflictingBlock is the conflicting header with the commit signed by the validator
+	ConflictingBlock *tmtypes.SignedHeader `json:"conflicting_block" yaml:"conflicting_block"`
+}
+
+// Route returns the Evidence Handler route for a LightClientAttack type.
+func (e LightClientAttack) Route() string { return RouteLightClientAttack }
+
+// Type returns the Evidence Handler type for a LightClientAttack type.
+func (e LightClientAttack) Type() string { return TypeLightClientAttack }
+
+func (e LightClientAttack) String() string {
+	bz, _ := yaml.Marshal(e)
+	return string(bz)
+}
+
+// Hash returns the hash of a LightClientAttack object, which only covers the
+// validator and the conflicting header it signed, so the same attack cannot be
+// submitted twice with different commits.
+func (e LightClientAttack) Hash() tmbytes.HexBytes {
+	var headerHash tmbytes.HexBytes
+	if e.ConflictingBlock != nil && e.ConflictingBlock.Header != nil {
+		headerHash = e.ConflictingBlock.Header.Hash()
+	}
+
+	return tmhash.Sum(Evidence_Cdc.amino.MustMarshalBinaryBare(struct {
+		ConsensusAddress sdk.ConsAddress
+		Height           int64
+		HeaderHash       tmbytes.HexBytes
+	}{e.ConsensusAddress, e.GetHeight(), headerHash}))
+}
+
+// ValidateBasic performs basic stateless validation checks on a LightClientAttack object.
+func (e LightClientAttack) ValidateBasic() error {
+	if e.ConsensusAddress.Empty() {
+		return fmt.Errorf("invalid light client attack validator consensus address: %s", e.ConsensusAddress)
+	}
+	if e.ConflictingBlock == nil || e.ConflictingBlock.Header == nil || e.ConflictingBlock.Commit == nil {
+		return fmt.Errorf("light client attack has no conflicting signed header")
+	}
+
+	header, commit := e.ConflictingBlock.Header, e.ConflictingBlock.Commit
+	if err := header.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid light client attack conflicting header: %w", err)
+	}
+	if err := commit.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid light client attack conflicting commit: %w", err)
+	}
+	if commit.Height != header.Height {
+		return fmt.Errorf("light client attack commit height %d mismatch %d", commit.Height, header.Height)
+	}
+	if hash := header.Hash(); len(hash) == 0 || !bytes.Equal(commit.BlockID.Hash, hash) {
+		return fmt.Errorf("light client attack commit is not for the conflicting header")
+	}
+	if e.signatureIndex() < 0 {
+		return fmt.Errorf("light client attack commit is not signed by %s", e.ConsensusAddress)
+	}
+
+	return nil
+}
+
+// signatureIndex returns the index of the validator's signature for the block in
+// the conflicting commit, or -1 if not signed.
+func (e LightClientAttack) signatureIndex() int {
+	for i, sig := range e.ConflictingBlock.Commit.Signatures {
+		if sig.ForBlock() && bytes.Equal(sig.ValidatorAddress, e.ConsensusAddress) {
+			return i
+		}
+	}
+	return -1
+}
+
+// GetConsensusAddress returns the validator's consensus address at time of the
+// LightClientAttack infraction.
+func (e LightClientAttack) GetConsensusAddress() sdk.ConsAddress {
+	return e.ConsensusAddress
+}
+
+// GetHeight returns the height at time of the LightClientAttack infraction.
+func (e LightClientAttack) GetHeight() int64 {
+	if e.ConflictingBlock == nil || e.ConflictingBlock.Header == nil {
+		return 0
+	}
+	return e.ConflictingBlock.Header.Height
+}
+
+// GetValidatorPower is a no-op for the LightClientAttack type, the power is read
+// from the historical validator set at the infraction height.
+func (e LightClientAttack) GetValidatorPower() int64 { return 0 }
+
+// GetTotalPower is a no-op for the LightClientAttack type.
+func (e LightClientAttack) GetTotalPower() int64 { return 0 }
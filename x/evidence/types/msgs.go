@@ -2,6 +2,7 @@ package types
 
 import (
 	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/evidence/exported"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -12,7 +13,8 @@ const (
 )
 
 var (
-	_ sdk.Msg = MsgSubmitEvidenceBase{}
+	_ sdk.Msg         = MsgSubmitEvidenceBase{}
+	_ types.KuMsgData = (*MsgSubmitEvidence)(nil)
 )
 
 // MsgSubmitEvidenceBase defines an sdk.Msg type that supports submitting arbitrary
@@ -62,3 +64,51 @@ func (m MsgSubmitEvidenceBase) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{}
 }
+
+// NewMsgSubmitEvidence returns a new MsgSubmitEvidence with a submitter and the
+// evidence to submit.
+func NewMsgSubmitEvidence(s types.AccountID, evidence exported.Evidence) (MsgSubmitEvidence, error) {
+	e := &Evidence{}
+	if err := e.SetEvidence(evidence); err != nil {
+		return MsgSubmitEvidence{}, err
+	}
+
+	return MsgSubmitEvidence{
+		Evidence:              e,
+		MsgSubmitEvidenceBase: NewMsgSubmitEvidenceBase(s),
+	}, nil
+}
+
+// Type returns the MsgSubmitEvidence's type.
+func (m MsgSubmitEvidence) Type() types.Name { return types.MustName("submitevidence") }
+
+// Sender returns the submitter of the evidence.
+func (m MsgSubmitEvidence) Sender() types.AccountID { return m.Submitter }
+
+// GetSubmitter returns the submitter of the evidence.
+func (m MsgSubmitEvidence) GetSubmitter() types.AccountID { return m.Submitter }
+
+// GetEvidence returns the evidence carried by the message.
+func (m MsgSubmitEvidence) GetEvidence() exported.Evidence {
+	if m.Evidence == nil {
+		return nil
+	}
+	return m.Evidence.GetEvidence()
+}
+
+// ValidateBasic performs basic (non-state-dependant) validation on a MsgSubmitEvidence.
+func (m MsgSubmitEvidence) ValidateBasic() error {
+	if err := m.MsgSubmitEvidenceBase.ValidateBasic(); err != nil {
+		return err
+	}
+
+	evidence := m.GetEvidence()
+	if evidence == nil {
+		return sdkerrors.Wrap(ErrInvalidEvidence, "missing evidence")
+	}
+	if err := evidence.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidEvidence, err.Error())
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/KuChainNetwork/kuchain/x/evidence/external"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"gopkg.in/yaml.v2"
 )
 
@@ -15,6 +16,15 @@ const (
 	DefaultParamspace            = ModuleName
 	DefaultMaxEvidenceAge        = 60 * 2 * time.Second
 	DefaultDoblesignJailDuration = 60 * 60 * 24 * 14 * time.Second
+
+	DefaultLightClientAttackJailDuration   = 60 * 60 * 24 * 14 * time.Second
+	DefaultInvalidPluginReportJailDuration = 60 * 60 * 24 * time.Second
+)
+
+// Default slash fractions
+var (
+	DefaultSlashFractionLightClientAttack   = sdk.NewDecWithPrec(5, 2)
+	DefaultSlashFractionInvalidPluginReport = sdk.NewDecWithPrec(1, 2)
 )
 
 // Parameter store keys
@@ -22,6 +32,11 @@ var (
 	KeyMaxEvidenceAge         = []byte("MaxEvidenceAge")
 	KeyDoubleSignJailDuration = []byte("DoubleSignJailDuration")
 
+	KeySlashFractionLightClientAttack   = []byte("SlashFractionLightClientAttack")
+	KeyLightClientAttackJailDuration    = []byte("LightClientAttackJailDuration")
+	KeySlashFractionInvalidPluginReport = []byte("SlashFractionInvalidPluginReport")
+	KeyInvalidPluginReportJailDuration  = []byte("InvalidPluginReportJailDuration")
+
 	// The Double Sign Jail period ends at Max Time supported by Amino
 	// (Dec 31, 9999 - 23:59:59 GMT).
 	DoubleSignJailEndTime = time.Unix(253402300799, 0)
@@ -36,6 +51,11 @@ func ParamKeyTable() external.ParamsKeyTable {
 type Params struct {
 	MaxEvidenceAge         time.Duration `json:"max_evidence_age" yaml:"max_evidence_age"`
 	DoubleSignJailDuration time.Duration `json:"double_sign_jail_duration" yaml:"double_sign_jail_duration"`
+
+	SlashFractionLightClientAttack   sdk.Dec       `json:"slash_fraction_light_client_attack" yaml:"slash_fraction_light_client_attack"`
+	LightClientAttackJailDuration    time.Duration `json:"light_client_attack_jail_duration" yaml:"light_client_attack_jail_duration"`
+	SlashFractionInvalidPluginReport sdk.Dec       `json:"slash_fraction_invalid_plugin_report" yaml:"slash_fraction_invalid_plugin_report"`
+	InvalidPluginReportJailDuration  time.Duration `json:"invalid_plugin_report_jail_duration" yaml:"invalid_plugin_report_jail_duration"`
 }
 
 func (p Params) String() string {
@@ -48,6 +68,10 @@ func (p *Params) ParamSetPairs() external.ParamSetPairs {
 	return external.ParamSetPairs{
 		external.ParamNewParamSetPair(KeyMaxEvidenceAge, &p.MaxEvidenceAge, validateMaxEvidenceAge),
 		external.ParamNewParamSetPair(KeyDoubleSignJailDuration, &p.DoubleSignJailDuration, validateDoubleSignJailDuration),
+		external.ParamNewParamSetPair(KeySlashFractionLightClientAttack, &p.SlashFractionLightClientAttack, validateSlashFraction),
+		external.ParamNewParamSetPair(KeyLightClientAttackJailDuration, &p.LightClientAttackJailDuration, validateJailDuration),
+		external.ParamNewParamSetPair(KeySlashFractionInvalidPluginReport, &p.SlashFractionInvalidPluginReport, validateSlashFraction),
+		external.ParamNewParamSetPair(KeyInvalidPluginReportJailDuration, &p.InvalidPluginReportJailDuration, validateJailDuration),
 	}
 }
 
@@ -56,6 +80,11 @@ func DefaultParams() Params {
 	return Params{
 		MaxEvidenceAge:         DefaultMaxEvidenceAge,
 		DoubleSignJailDuration: DefaultDoblesignJailDuration,
+
+		SlashFractionLightClientAttack:   DefaultSlashFractionLightClientAttack,
+		LightClientAttackJailDuration:    DefaultLightClientAttackJailDuration,
+		SlashFractionInvalidPluginReport: DefaultSlashFractionInvalidPluginReport,
+		InvalidPluginReportJailDuration:  DefaultInvalidPluginReportJailDuration,
 	}
 }
 
@@ -84,3 +113,32 @@ func validateDoubleSignJailDuration(i interface{}) error {
 
 	return nil
 }
+
+func validateSlashFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("slash fraction cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("slash fraction too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateJailDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("jail duration must be positive: %s", v)
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/x/evidence/exported"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	"gopkg.in/yaml.v2"
+)
+
+// Evidence type constants
+const (
+	RouteInvalidPluginReport = "invalidpluginreport"
+	TypeInvalidPluginReport  = "invalid_plugin_report"
+)
+
+var _ exported.Evidence = (*InvalidPluginReport)(nil)
+
+// PluginReport is a report about an off-chain result (an oracle price, a plugin
+// output) signed by a validator with its consensus key.
+type PluginReport struct {
+	ReportHash tmbytes.HexBytes `json:"report_hash" yaml:"report_hash"`
+	Signature  []byte           `json:"signature" yaml:"signature"`
+}
+
+// PluginReportSignBytes returns the bytes a validator signs for a report of plugin
+// at height in the chain chainID.
+func PluginReportSignBytes(chainID, plugin string, height int64, reportHash tmbytes.HexBytes) []byte {
+	return sdk.MustSortJSON(Evidence_Cdc.amino.MustMarshalJSON(struct {
+		ChainID    string           `json:"chain_id"`
+		Plugin     string           `json:"plugin"`
+		Height     int64            `json:"height"`
+		ReportHash tmbytes.HexBytes `json:"report_hash"`
+	}{chainID, plugin, height, reportHash}))
+}
+
+// InvalidPluginReport implements the Evidence interface and defines evidence of a
+// validator signing two conflicting reports for the same plugin and height.
+//
+// The evidence only carries signed material, the power of the validator and the
+// time of the infraction are read from the chain history when it is handled.
+type InvalidPluginReport struct {
+	Height           int64           `json:"height,omitempty" yaml:"height"`
+	ConsensusAddress sdk.ConsAddress `json:"consensus_address,omitempty" yaml:"consensus_address"`
+	Plugin           string          `json:"plugin" yaml:"plugin"`
+	ReportA          PluginReport    `json:"report_a" yaml:"report_a"`
+	ReportB          PluginReport    `json:"report_b" yaml:"report_b"`
+}
+
+// Route returns the Evidence Handler route for an InvalidPluginReport type.
+func (e InvalidPluginReport) Route() string { return RouteInvalidPluginReport }
+
+// Type returns the Evidence Handler type for an InvalidPluginReport type.
+func (e InvalidPluginReport) Type() string { return TypeInvalidPluginReport }
+
+func (e InvalidPluginReport) String() string {
+	bz, _ := yaml.Marshal(e)
+	return string(bz)
+}
+
+// Hash returns the hash of an InvalidPluginReport object, which only covers the
+// signed material, the reports are ordered so swapping them gives the same hash.
+func (e InvalidPluginReport) Hash() tmbytes.HexBytes {
+	reportA, reportB := e.ReportA.ReportHash, e.ReportB.ReportHash
+	if bytes.Compare(reportA, reportB) > 0 {
+		reportA, reportB = reportB, reportA
+	}
+
+	return tmhash.Sum(Evidence_Cdc.amino.MustMarshalBinaryBare(struct {
+		ConsensusAddress sdk.ConsAddress
+		Plugin           string
+		Height           int64
+		ReportA          tmbytes.HexBytes
+		ReportB          tmbytes.HexBytes
+	}{e.ConsensusAddress, e.Plugin, e.Height, reportA, reportB}))
+}
+
+// ValidateBasic performs basic stateless validation checks on an InvalidPluginReport object.
+func (e InvalidPluginReport) ValidateBasic() error {
+	if e.Height < 1 {
+		return fmt.Errorf("invalid plugin report height: %d", e.Height)
+	}
+	if e.ConsensusAddress.Empty() {
+		return fmt.Errorf("invalid plugin report validator consensus address: %s", e.ConsensusAddress)
+	}
+	if e.Plugin == "" {
+		return fmt.Errorf("invalid plugin report plugin name is empty")
+	}
+	if len(e.ReportA.ReportHash) == 0 || len(e.ReportB.ReportHash) == 0 {
+		return fmt.Errorf("invalid plugin report hash is empty")
+	}
+	if len(e.ReportA.Signature) == 0 || len(e.ReportB.Signature) == 0 {
+		return fmt.Errorf("invalid plugin report signature is empty")
+	}
+	if bytes.Equal(e.ReportA.ReportHash, e.ReportB.ReportHash) {
+		return fmt.Errorf("plugin reports do not conflict")
+	}
+
+	return nil
+}
+
+// GetConsensusAddress returns the validator's consensus address at time of the
+// InvalidPluginReport infraction.
+func (e InvalidPluginReport) GetConsensusAddress() sdk.ConsAddress {
+	return e.ConsensusAddress
+}
+
+// GetHeight returns the height at time of the InvalidPluginReport infraction.
+func (e InvalidPluginReport) GetHeight() int64 {
+	return e.Height
+}
+
+// GetValidatorPower is a no-op for the InvalidPluginReport type, the power is read
+// from the historical validator set at the infraction height.
+func (e InvalidPluginReport) GetValidatorPower() int64 { return 0 }
+
+// GetTotalPower is a no-op for the InvalidPluginReport type.
+func (e InvalidPluginReport) GetTotalPower() int64 { return 0 }
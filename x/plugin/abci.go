@@ -3,21 +3,27 @@ package plugin
 import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/KuChainNetwork/kuchain/plugins"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// BeginBlocker check for infraction evidence or downtime of validators
+// BeginBlocker notifies plugins a new block begins
 // on every begin block
 func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) {
 	logger := Logger(ctx)
 
-	logger.Info("begin block", "req", req)
+	logger.Debug("begin block", "height", req.Header.Height)
+
+	plugins.HandleBeginBlock(ctx, req)
 }
 
+// EndBlocker notifies plugins all txs in the block are delivered
 func EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) []abci.ValidatorUpdate {
 	logger := Logger(ctx)
 
-	logger.Info("end block", "req", req)
+	logger.Debug("end block", "height", req.Height)
+
+	plugins.HandleEndBlock(ctx, req)
 
 	return []abci.ValidatorUpdate{}
 }
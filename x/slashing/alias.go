@@ -8,16 +8,18 @@ import (
 )
 
 const (
-	ModuleName                  = types.ModuleName
-	StoreKey                    = types.StoreKey
-	RouterKey                   = types.RouterKey
-	QuerierRoute                = types.QuerierRoute
-	DefaultParamspace           = types.DefaultParamspace
-	DefaultSignedBlocksWindow   = types.DefaultSignedBlocksWindow
-	DefaultDowntimeJailDuration = types.DefaultDowntimeJailDuration
-	QueryParameters             = types.QueryParameters
-	QuerySigningInfo            = types.QuerySigningInfo
-	QuerySigningInfos           = types.QuerySigningInfos
+	ModuleName                   = types.ModuleName
+	StoreKey                     = types.StoreKey
+	RouterKey                    = types.RouterKey
+	QuerierRoute                 = types.QuerierRoute
+	DefaultParamspace            = types.DefaultParamspace
+	DefaultSignedBlocksWindow    = types.DefaultSignedBlocksWindow
+	DefaultDowntimeJailDuration  = types.DefaultDowntimeJailDuration
+	DefaultDowntimeOffenceWindow = types.DefaultDowntimeOffenceWindow
+	DefaultMaxDowntimeOffences   = types.DefaultMaxDowntimeOffences
+	QueryParameters              = types.QueryParameters
+	QuerySigningInfo             = types.QuerySigningInfo
+	QuerySigningInfos            = types.QuerySigningInfos
 
 	EventTypeSlash                 = types.EventTypeSlash
 	EventTypeLiveness              = types.EventTypeLiveness
@@ -69,11 +71,15 @@ var (
 	DefaultMinSignedPerWindow       = types.DefaultMinSignedPerWindow
 	DefaultSlashFractionDoubleSign  = types.DefaultSlashFractionDoubleSign
 	DefaultSlashFractionDowntime    = types.DefaultSlashFractionDowntime
+	DefaultDowntimePenaltyFactor    = types.DefaultDowntimePenaltyFactor
 	KeySignedBlocksWindow           = types.KeySignedBlocksWindow
 	KeyMinSignedPerWindow           = types.KeyMinSignedPerWindow
 	KeyDowntimeJailDuration         = types.KeyDowntimeJailDuration
 	KeySlashFractionDoubleSign      = types.KeySlashFractionDoubleSign
 	KeySlashFractionDowntime        = types.KeySlashFractionDowntime
+	KeyDowntimeOffenceWindow        = types.KeyDowntimeOffenceWindow
+	KeyDowntimePenaltyFactor        = types.KeyDowntimePenaltyFactor
+	KeyMaxDowntimeOffences          = types.KeyMaxDowntimeOffences
 )
 
 type (
@@ -2,12 +2,16 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/KuChainNetwork/kuchain/x/slashing/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/crypto"
 )
 
+// maxDowntimeJailDuration bounds the escalated downtime jail duration
+const maxDowntimeJailDuration = 100 * 365 * 24 * time.Hour
+
 // HandleValidatorSignature handles a validator signature, must be called once per validator per block.
 func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr crypto.Address, power int64, signed bool) {
 	logger := k.Logger(ctx)
@@ -90,10 +94,26 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 					sdk.NewAttribute(types.AttributeKeyJailed, consAddr.String()),
 				),
 			)
-			k.sk.Slash(ctx, consAddr, distributionHeight, power, k.SlashFractionDowntime(ctx))
+
+			// repeated offences within the offence window escalate the penalty
+			blockTime := ctx.BlockHeader().Time
+			if signInfo.DowntimeOffences > 0 && blockTime.After(signInfo.LastDowntimeTime.Add(k.DowntimeOffenceWindow(ctx))) {
+				signInfo.DowntimeOffences = 0
+			}
+			signInfo.DowntimeOffences++
+			signInfo.LastDowntimeTime = blockTime
+
+			slashFraction, jailDuration := k.DowntimePenalty(ctx, signInfo.DowntimeOffences)
+			k.sk.Slash(ctx, consAddr, distributionHeight, power, slashFraction)
 			k.sk.Jail(ctx, consAddr)
 
-			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(k.DowntimeJailDuration(ctx))
+			signInfo.JailedUntil = blockTime.Add(jailDuration)
+
+			maxOffences := k.MaxDowntimeOffences(ctx)
+			if maxOffences > 0 && signInfo.DowntimeOffences >= maxOffences {
+				logger.Info(fmt.Sprintf("Validator %s tombstoned after %d downtime offences", consAddr, signInfo.DowntimeOffences))
+				signInfo.Tombstoned = true
+			}
 
 			// We need to reset the counter & array so that the validator won't be immediately slashed for downtime upon rebonding.
 			signInfo.MissedBlocksCounter = 0
@@ -110,3 +130,23 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 	// Set the updated signing info
 	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
 }
+
+// DowntimePenalty returns the slash fraction and jail duration for the given count of
+// downtime offences within the offence window. Both grow by DowntimePenaltyFactor for
+// each repeated offence, the slash fraction is capped at one.
+func (k Keeper) DowntimePenalty(ctx sdk.Context, offences int64) (sdk.Dec, time.Duration) {
+	fraction := k.SlashFractionDowntime(ctx)
+	jail := sdk.NewDec(int64(k.DowntimeJailDuration(ctx)))
+	factor := k.DowntimePenaltyFactor(ctx)
+	maxJail := sdk.NewDec(int64(maxDowntimeJailDuration))
+
+	for i := int64(1); i < offences; i++ {
+		if fraction.GTE(sdk.OneDec()) && jail.GTE(maxJail) {
+			break
+		}
+		fraction = sdk.MinDec(fraction.Mul(factor), sdk.OneDec())
+		jail = sdk.MinDec(jail.Mul(factor), maxJail)
+	}
+
+	return fraction, time.Duration(jail.TruncateInt64())
+}
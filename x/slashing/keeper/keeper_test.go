@@ -183,6 +183,7 @@ func TestKeeper(t *testing.T) {
 		require.True(t, found)
 		require.Equal(t, int64(0), signInfo.MissedBlocksCounter)
 		require.Equal(t, int64(0), signInfo.IndexOffset)
+		require.Equal(t, int64(1), signInfo.DowntimeOffences)
 		// array should be cleared
 		for offset := int64(0); offset < keeper.SignedBlocksWindow(ctx); offset++ {
 			missed := keeper.GetValidatorMissedBlockBitArray(ctx, consAddr, offset)
@@ -217,6 +218,71 @@ func TestKeeper(t *testing.T) {
 		staking.EndBlocker(ctx, *stakeKeeper)
 		validator, _ = stakeKeeper.GetValidator(ctx, accAlice)
 		require.Equal(t, exported.Unbonding, validator.Status)
+
+		// repeated offence within the offence window escalates the jail duration
+		signInfo, found = keeper.GetValidatorSigningInfo(ctx, consAddr)
+		require.True(t, found)
+		require.Equal(t, int64(2), signInfo.DowntimeOffences)
+		_, jailDuration := keeper.DowntimePenalty(ctx, 2)
+		require.Equal(t, ctx.BlockHeader().Time.Add(jailDuration), signInfo.JailedUntil)
+		require.True(t, jailDuration > keeper.DowntimeJailDuration(ctx))
+
+		// tombstoning for repeated offences is disabled by default
+		require.Equal(t, int64(0), keeper.MaxDowntimeOffences(ctx))
+		require.False(t, signInfo.Tombstoned)
+
+		// enable it, the next offence within the window tombstones the validator
+		slashParams := keeper.GetParams(ctx)
+		slashParams.MaxDowntimeOffences = 3
+		keeper.SetParams(ctx, slashParams)
+
+		height += 100
+		ctx = app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + height})
+		stakeKeeper.Unjail(ctx, consAddr)
+		staking.EndBlocker(ctx, *stakeKeeper)
+
+		latest = height
+		for ; height < latest+501; height++ {
+			ctx = app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + height})
+			keeper.HandleValidatorSignature(ctx, pk.Address(), newPower, false)
+		}
+
+		signInfo, found = keeper.GetValidatorSigningInfo(ctx, consAddr)
+		require.True(t, found)
+		require.Equal(t, int64(3), signInfo.DowntimeOffences)
+		require.True(t, signInfo.Tombstoned)
 	})
 
 }
+
+func TestDowntimePenalty(t *testing.T) {
+	wallet := simapp.NewWallet()
+	Convey("TestDowntimePenalty", t, func() {
+		_, _, _, _, _, _, app := NewTestApp(wallet)
+		keeper := app.SlashKeeper()
+		ctx := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1})
+
+		params := keeper.GetParams(ctx)
+		params.SlashFractionDowntime = sdk.NewDecWithPrec(1, 1)
+		params.DowntimeJailDuration = time.Hour
+		params.DowntimePenaltyFactor = sdk.NewDec(3)
+		keeper.SetParams(ctx, params)
+
+		fraction, jail := keeper.DowntimePenalty(ctx, 1)
+		So(fraction, ShouldResemble, sdk.NewDecWithPrec(1, 1))
+		So(jail, ShouldEqual, time.Hour)
+
+		fraction, jail = keeper.DowntimePenalty(ctx, 2)
+		So(fraction, ShouldResemble, sdk.NewDecWithPrec(3, 1))
+		So(jail, ShouldEqual, 3*time.Hour)
+
+		// slash fraction is capped at one
+		fraction, jail = keeper.DowntimePenalty(ctx, 4)
+		So(fraction, ShouldResemble, sdk.OneDec())
+		So(jail, ShouldEqual, 27*time.Hour)
+
+		// jail duration stays bounded for large offence counts
+		_, jail = keeper.DowntimePenalty(ctx, 1000)
+		So(jail, ShouldBeGreaterThan, 27*time.Hour)
+	})
+}
@@ -42,6 +42,24 @@ func (k Keeper) SlashFractionDowntime(ctx sdk.Context) (res sdk.Dec) {
 	return
 }
 
+// DowntimeOffenceWindow - period within which repeated downtime offences escalate
+func (k Keeper) DowntimeOffenceWindow(ctx sdk.Context) (res time.Duration) {
+	k.paramspace.Get(ctx, types.KeyDowntimeOffenceWindow, &res)
+	return
+}
+
+// DowntimePenaltyFactor - multiplier of jail duration and slash fraction for each repeated downtime offence
+func (k Keeper) DowntimePenaltyFactor(ctx sdk.Context) (res sdk.Dec) {
+	k.paramspace.Get(ctx, types.KeyDowntimePenaltyFactor, &res)
+	return
+}
+
+// MaxDowntimeOffences - number of downtime offences within the window after which a validator is tombstoned
+func (k Keeper) MaxDowntimeOffences(ctx sdk.Context) (res int64) {
+	k.paramspace.Get(ctx, types.KeyMaxDowntimeOffences, &res)
+	return
+}
+
 // GetParams returns the total set of slashing parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramspace.GetParamSet(ctx, &params)
@@ -22,6 +22,9 @@ const (
 	DowntimeJailDuration    = "downtime_jail_duration"
 	SlashFractionDoubleSign = "slash_fraction_double_sign"
 	SlashFractionDowntime   = "slash_fraction_downtime"
+	DowntimeOffenceWindow   = "downtime_offence_window"
+	DowntimePenaltyFactor   = "downtime_penalty_factor"
+	MaxDowntimeOffences     = "max_downtime_offences"
 )
 
 // GenSignedBlocksWindow randomized SignedBlocksWindow
@@ -49,6 +52,21 @@ func GenSlashFractionDowntime(r *rand.Rand) sdk.Dec {
 	return sdk.NewDec(1).Quo(sdk.NewDec(int64(r.Intn(200) + 1)))
 }
 
+// GenDowntimeOffenceWindow randomized DowntimeOffenceWindow
+func GenDowntimeOffenceWindow(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 60*60, 60*60*24*14)) * time.Second
+}
+
+// GenDowntimePenaltyFactor randomized DowntimePenaltyFactor
+func GenDowntimePenaltyFactor(r *rand.Rand) sdk.Dec {
+	return sdk.OneDec().Add(sdk.NewDecWithPrec(int64(r.Intn(21)), 1))
+}
+
+// GenMaxDowntimeOffences randomized MaxDowntimeOffences
+func GenMaxDowntimeOffences(r *rand.Rand) int64 {
+	return int64(r.Intn(10))
+}
+
 // RandomizedGenState generates a random GenesisState for slashing
 func RandomizedGenState(simState *module.SimulationState) {
 	var signedBlocksWindow int64
@@ -81,9 +99,28 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { slashFractionDowntime = GenSlashFractionDowntime(r) },
 	)
 
+	var downtimeOffenceWindow time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DowntimeOffenceWindow, &downtimeOffenceWindow, simState.Rand,
+		func(r *rand.Rand) { downtimeOffenceWindow = GenDowntimeOffenceWindow(r) },
+	)
+
+	var downtimePenaltyFactor sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DowntimePenaltyFactor, &downtimePenaltyFactor, simState.Rand,
+		func(r *rand.Rand) { downtimePenaltyFactor = GenDowntimePenaltyFactor(r) },
+	)
+
+	var maxDowntimeOffences int64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxDowntimeOffences, &maxDowntimeOffences, simState.Rand,
+		func(r *rand.Rand) { maxDowntimeOffences = GenMaxDowntimeOffences(r) },
+	)
+
 	params := types.NewParams(
 		signedBlocksWindow, minSignedPerWindow, downtimeJailDuration,
 		slashFractionDoubleSign, slashFractionDowntime,
+		downtimeOffenceWindow, downtimePenaltyFactor, maxDowntimeOffences,
 	)
 
 	slashingGenesis := types.NewGenesisState(params, nil, nil)
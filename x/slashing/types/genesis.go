@@ -83,6 +83,18 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("downtime unblond duration must be at least 1 minute, is %s", downtimeJail.String())
 	}
 
+	if err := validateDowntimeOffenceWindow(data.Params.DowntimeOffenceWindow); err != nil {
+		return err
+	}
+
+	if err := validateDowntimePenaltyFactor(data.Params.DowntimePenaltyFactor); err != nil {
+		return err
+	}
+
+	if err := validateMaxDowntimeOffences(data.Params.MaxDowntimeOffences); err != nil {
+		return err
+	}
+
 	signedWindow := data.Params.SignedBlocksWindow
 	if signedWindow < 10 {
 		return fmt.Errorf("signed blocks window must be at least 10, is %d", signedWindow)
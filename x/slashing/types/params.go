@@ -13,12 +13,16 @@ const (
 	DefaultParamspace           = ModuleName
 	DefaultSignedBlocksWindow   = int64(100)
 	DefaultDowntimeJailDuration = 60 * 10 * time.Second
+
+	DefaultDowntimeOffenceWindow = 7 * 24 * time.Hour
+	DefaultMaxDowntimeOffences   = int64(0)
 )
 
 var (
 	DefaultMinSignedPerWindow      = sdk.NewDecWithPrec(5, 1)
 	DefaultSlashFractionDoubleSign = sdk.NewDec(1).Quo(sdk.NewDec(20))
 	DefaultSlashFractionDowntime   = sdk.NewDec(1).Quo(sdk.NewDec(10000))
+	DefaultDowntimePenaltyFactor   = sdk.NewDec(2)
 )
 
 // Parameter store keys
@@ -28,6 +32,9 @@ var (
 	KeyDowntimeJailDuration    = []byte("DowntimeJailDuration")
 	KeySlashFractionDoubleSign = []byte("SlashFractionDoubleSign")
 	KeySlashFractionDowntime   = []byte("SlashFractionDowntime")
+	KeyDowntimeOffenceWindow   = []byte("DowntimeOffenceWindow")
+	KeyDowntimePenaltyFactor   = []byte("DowntimePenaltyFactor")
+	KeyMaxDowntimeOffences     = []byte("MaxDowntimeOffences")
 )
 
 // ParamKeyTable for slashing module
@@ -42,12 +49,21 @@ type Params struct {
 	DowntimeJailDuration    time.Duration `json:"downtime_jail_duration" yaml:"downtime_jail_duration"`
 	SlashFractionDoubleSign sdk.Dec       `json:"slash_fraction_double_sign" yaml:"slash_fraction_double_sign"`
 	SlashFractionDowntime   sdk.Dec       `json:"slash_fraction_downtime" yaml:"slash_fraction_downtime"`
+
+	// DowntimeOffenceWindow is the period within which repeated downtime offences escalate
+	DowntimeOffenceWindow time.Duration `json:"downtime_offence_window" yaml:"downtime_offence_window"`
+	// DowntimePenaltyFactor multiplies jail duration and slash fraction on each repeated offence
+	DowntimePenaltyFactor sdk.Dec `json:"downtime_penalty_factor" yaml:"downtime_penalty_factor"`
+	// MaxDowntimeOffences is the number of offences within the window after which a validator is tombstoned,
+	// 0 disables it and is the default, governance can enable it by a param change
+	MaxDowntimeOffences int64 `json:"max_downtime_offences" yaml:"max_downtime_offences"`
 }
 
 // NewParams creates a new Params object
 func NewParams(
 	signedBlocksWindow int64, minSignedPerWindow sdk.Dec, downtimeJailDuration time.Duration,
 	slashFractionDoubleSign, slashFractionDowntime sdk.Dec,
+	downtimeOffenceWindow time.Duration, downtimePenaltyFactor sdk.Dec, maxDowntimeOffences int64,
 ) Params {
 
 	return Params{
@@ -56,6 +72,9 @@ func NewParams(
 		DowntimeJailDuration:    downtimeJailDuration,
 		SlashFractionDoubleSign: slashFractionDoubleSign,
 		SlashFractionDowntime:   slashFractionDowntime,
+		DowntimeOffenceWindow:   downtimeOffenceWindow,
+		DowntimePenaltyFactor:   downtimePenaltyFactor,
+		MaxDowntimeOffences:     maxDowntimeOffences,
 	}
 }
 
@@ -66,10 +85,14 @@ func (p Params) String() string {
   MinSignedPerWindow:      %s
   DowntimeJailDuration:    %s
   SlashFractionDoubleSign: %s
-  SlashFractionDowntime:   %s`,
+  SlashFractionDowntime:   %s
+  DowntimeOffenceWindow:   %s
+  DowntimePenaltyFactor:   %s
+  MaxDowntimeOffences:     %d`,
 		p.SignedBlocksWindow, p.MinSignedPerWindow,
 		p.DowntimeJailDuration, p.SlashFractionDoubleSign,
-		p.SlashFractionDowntime)
+		p.SlashFractionDowntime, p.DowntimeOffenceWindow,
+		p.DowntimePenaltyFactor, p.MaxDowntimeOffences)
 }
 
 // ParamSetPairs - Implements params.ParamSet
@@ -80,6 +103,9 @@ func (p *Params) ParamSetPairs() external.ParamSetPairs {
 		external.ParamNewParamSetPair(KeyDowntimeJailDuration, &p.DowntimeJailDuration, validateDowntimeJailDuration),
 		external.ParamNewParamSetPair(KeySlashFractionDoubleSign, &p.SlashFractionDoubleSign, validateSlashFractionDoubleSign),
 		external.ParamNewParamSetPair(KeySlashFractionDowntime, &p.SlashFractionDowntime, validateSlashFractionDowntime),
+		external.ParamNewParamSetPair(KeyDowntimeOffenceWindow, &p.DowntimeOffenceWindow, validateDowntimeOffenceWindow),
+		external.ParamNewParamSetPair(KeyDowntimePenaltyFactor, &p.DowntimePenaltyFactor, validateDowntimePenaltyFactor),
+		external.ParamNewParamSetPair(KeyMaxDowntimeOffences, &p.MaxDowntimeOffences, validateMaxDowntimeOffences),
 	}
 }
 
@@ -88,6 +114,7 @@ func DefaultParams() Params {
 	return NewParams(
 		DefaultSignedBlocksWindow, DefaultMinSignedPerWindow, DefaultDowntimeJailDuration,
 		DefaultSlashFractionDoubleSign, DefaultSlashFractionDowntime,
+		DefaultDowntimeOffenceWindow, DefaultDowntimePenaltyFactor, DefaultMaxDowntimeOffences,
 	)
 }
 
@@ -164,3 +191,42 @@ func validateSlashFractionDowntime(i interface{}) error {
 
 	return nil
 }
+
+func validateDowntimeOffenceWindow(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("downtime offence window cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateDowntimePenaltyFactor(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.LT(sdk.OneDec()) {
+		return fmt.Errorf("downtime penalty factor must be at least one: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxDowntimeOffences(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("max downtime offences cannot be negative: %d", v)
+	}
+
+	return nil
+}
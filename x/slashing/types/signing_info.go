@@ -21,6 +21,10 @@ type ValidatorSigningInfo struct {
 	Tombstoned bool `json:"tombstoned,omitempty"`
 	// missed blocks counter (to avoid scanning the array every time)
 	MissedBlocksCounter int64 `json:"missed_blocks_counter,omitempty" yaml:"missed_blocks_counter"`
+	// downtime offences committed within the current offence window
+	DowntimeOffences int64 `json:"downtime_offences,omitempty" yaml:"downtime_offences"`
+	// timestamp of the last downtime offence
+	LastDowntimeTime time.Time `json:"last_downtime_time" yaml:"last_downtime_time"`
 }
 
 // NewValidatorSigningInfo creates a new ValidatorSigningInfo instance
@@ -47,9 +51,11 @@ func (i ValidatorSigningInfo) String() string {
   Index Offset:          %d
   Jailed Until:          %v
   Tombstoned:            %t
-  Missed Blocks Counter: %d`,
+  Missed Blocks Counter: %d
+  Downtime Offences:     %d
+  Last Downtime Time:    %v`,
 		i.Address, i.StartHeight, i.IndexOffset, i.JailedUntil,
-		i.Tombstoned, i.MissedBlocksCounter)
+		i.Tombstoned, i.MissedBlocksCounter, i.DowntimeOffences, i.LastDowntimeTime)
 }
 
 // unmarshal a validator signing info from a store value
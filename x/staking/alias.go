@@ -35,6 +35,7 @@ const (
 	QueryPool                          = types.QueryPool
 	QueryParameters                    = types.QueryParameters
 	QueryHistoricalInfo                = types.QueryHistoricalInfo
+	QueryValidatorPower                = types.QueryValidatorPower
 	MaxMonikerLength                   = types.MaxMonikerLength
 	MaxIdentityLength                  = types.MaxIdentityLength
 	MaxWebsiteLength                   = types.MaxWebsiteLength
@@ -78,6 +79,7 @@ var (
 	MustMarshalHistoricalInfo          = types.MustMarshalHistoricalInfo
 	MustUnmarshalHistoricalInfo        = types.MustUnmarshalHistoricalInfo
 	UnmarshalHistoricalInfo            = types.UnmarshalHistoricalInfo
+	NewValidatorPowerRecord            = types.NewValidatorPowerRecord
 	ErrEmptyValidatorAddr              = types.ErrEmptyValidatorAddr
 	ErrBadValidatorAddr                = types.ErrBadValidatorAddr
 	ErrNoValidatorFound                = types.ErrNoValidatorFound
@@ -111,6 +113,10 @@ var (
 	ErrNotMature                       = types.ErrNotMature
 	ErrNoUnbondingDelegation           = types.ErrNoUnbondingDelegation
 	ErrMaxUnbondingDelegationEntries   = types.ErrMaxUnbondingDelegationEntries
+	ErrNoUnbondingDelegationEntry      = types.ErrNoUnbondingDelegationEntry
+	ErrBadCancelUnbondingAmount        = types.ErrBadCancelUnbondingAmount
+	ErrBadCancelUnbondingHeight        = types.ErrBadCancelUnbondingHeight
+	ErrValidatorPowerExceedsSoftCap    = types.ErrValidatorPowerExceedsSoftCap
 	ErrBadRedelegationAddr             = types.ErrBadRedelegationAddr
 	ErrNoRedelegation                  = types.ErrNoRedelegation
 	ErrSelfRedelegation                = types.ErrSelfRedelegation
@@ -154,11 +160,14 @@ var (
 	GetREDsToValDstIndexKey            = types.GetREDsToValDstIndexKey
 	GetREDsByDelToValDstIndexKey       = types.GetREDsByDelToValDstIndexKey
 	GetHistoricalInfoKey               = types.GetHistoricalInfoKey
+	GetValidatorPowerRecordsKey        = types.GetValidatorPowerRecordsKey
+	GetValidatorPowerRecordKey         = types.GetValidatorPowerRecordKey
 	NewMsgCreateValidator              = types.NewMsgCreateValidator
 	NewMsgEditValidator                = types.NewMsgEditValidator
 	NewMsgDelegate                     = types.NewMsgDelegate
 	NewMsgBeginRedelegate              = types.NewMsgBeginRedelegate
 	NewMsgUndelegate                   = types.NewMsgUndelegate
+	NewMsgCancelUnbonding              = types.NewMsgCancelUnbonding
 	NewParams                          = types.NewParams
 	DefaultParams                      = types.DefaultParams
 	MustUnmarshalParams                = types.MustUnmarshalParams
@@ -166,6 +175,8 @@ var (
 	NewPool                            = types.NewPool
 	NewQueryDelegatorParams            = types.NewQueryDelegatorParams
 	NewQueryValidatorParams            = types.NewQueryValidatorParams
+	NewValidatorPower                  = types.NewValidatorPower
+	CapValidatorPowers                 = keeper.CapValidatorPowers
 	NewQueryBondsParams                = types.NewQueryBondsParams
 	NewQueryRedelegationParams         = types.NewQueryRedelegationParams
 	NewQueryValidatorsParams           = types.NewQueryValidatorsParams
@@ -180,6 +191,7 @@ var (
 	ModuleCdc                        = types.ModuleCdc
 	LastValidatorPowerKey            = types.LastValidatorPowerKey
 	LastTotalPowerKey                = types.LastTotalPowerKey
+	ValidatorPowerRecordKey          = types.ValidatorPowerRecordKey
 	ValidatorsKey                    = types.ValidatorsKey
 	ValidatorsByConsAddrKey          = types.ValidatorsByConsAddrKey
 	ValidatorsByPowerIndexKey        = types.ValidatorsByPowerIndexKey
@@ -216,6 +228,8 @@ type (
 	RedelegationEntry         = types.RedelegationEntry
 	Redelegations             = types.Redelegations
 	HistoricalInfo            = types.HistoricalInfo
+	ValidatorPowerRecord      = types.ValidatorPowerRecord
+	ValidatorPower            = types.ValidatorPower
 	DelegationResponse        = types.DelegationResponse
 	DelegationResponses       = types.DelegationResponses
 	RedelegationResponse      = types.RedelegationResponse
@@ -229,6 +243,7 @@ type (
 	MsgDelegate               = types.MsgDelegate
 	MsgBeginRedelegate        = types.MsgBeginRedelegate
 	MsgUndelegate             = types.MsgUndelegate
+	MsgCancelUnbonding        = types.MsgCancelUnbonding
 	Params                    = types.Params
 	Pool                      = types.Pool
 	QueryDelegatorParams      = types.QueryDelegatorParams
@@ -34,6 +34,7 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryRedelegations(queryRoute, cdc),
 		GetCmdQueryValidator(queryRoute, cdc),
 		GetCmdQueryValidators(queryRoute, cdc),
+		GetCmdQueryValidatorPower(queryRoute, cdc),
 		GetCmdQueryValidatorDelegations(queryRoute, cdc),
 		GetCmdQueryValidatorUnbondingDelegations(queryRoute, cdc),
 		GetCmdQueryValidatorRedelegations(queryRoute, cdc),
@@ -87,6 +88,51 @@ $ %s query kustaking validator jack
 	}
 }
 
+// GetCmdQueryValidatorPower implements the validator voting power query command.
+func GetCmdQueryValidatorPower(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "validator-power [validator-account]",
+		Short: "Query the voting power of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the voting power of a validator by bonded tokens and the effective power
+in consensus after the voting power cap applied.
+
+Example:
+$ %s query kustaking validator-power jack
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			valAccount, err := chainTypes.NewAccountIDFromStr(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryValidatorParams(valAccount))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryValidatorPower)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var power types.ValidatorPower
+			if err := cdc.UnmarshalJSON(res, &power); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(power)
+		},
+	}
+}
+
 // GetCmdQueryValidators implements the query all validators command.
 func GetCmdQueryValidators(storeName string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/KuChainNetwork/kuchain/chain/client/flags"
@@ -39,6 +40,7 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 		GetCmdDelegate(cdc),
 		GetCmdRedelegate(storeKey, cdc),
 		GetCmdUnbond(storeKey, cdc),
+		GetCmdCancelUnbonding(storeKey, cdc),
 	)...)
 
 	return stakingTxCmd
@@ -301,6 +303,62 @@ $ %s tx kustaking unbond jack validator 100stake --from jack
 	}
 }
 
+// GetCmdCancelUnbonding implements the cancel unbonding delegation command.
+func GetCmdCancelUnbonding(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel-unbond [delegate-account] [validator-account] [amount] [creation-height]",
+		Short: "Cancel unbonding delegation and delegate back to the validator",
+		Args:  cobra.ExactArgs(4),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel an amount of unbonding delegation entry created at creation-height,
+the coins will be delegated back to the original validator.
+
+Example:
+$ %s tx kustaking cancel-unbond jack validator 100stake 123456 --from jack
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
+			cliCtx := txutil.NewKuCLICtxByBuf(cdc, inBuf)
+
+			delAccountID, err := chainTypes.NewAccountIDFromStr(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "delegate account id error")
+			}
+
+			valAddr, err := chainTypes.NewAccountIDFromStr(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "val account id error")
+			}
+
+			amount, err := chainTypes.ParseCoin(args[2])
+			if err != nil {
+				return err
+			}
+
+			creationHeight, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "creation height error")
+			}
+
+			delAccAddress, err := txutil.QueryAccountAuth(cliCtx, delAccountID)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "query account %s auth error", delAccountID)
+			}
+
+			msg := types.NewKuMsgCancelUnbonding(delAccAddress, delAccountID, valAddr, amount, creationHeight)
+			cliCtx = cliCtx.WithFromAccount(delAccountID)
+			if txBldr.FeePayer().Empty() {
+				txBldr = txBldr.WithPayer(args[0])
+			}
+			return txutil.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 //__________________________________________________________
 
 var (
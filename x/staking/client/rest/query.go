@@ -88,6 +88,12 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		validatorUnbondingDelegationsHandlerFn(cliCtx),
 	).Methods("GET")
 
+	// Get the voting power of a validator
+	r.HandleFunc(
+		"/staking/validators/{validatorAddr}/power",
+		validatorPowerHandlerFn(cliCtx),
+	).Methods("GET")
+
 	// Get HistoricalInfo at a given height
 	r.HandleFunc(
 		"/staking/historical_info/{height}",
@@ -319,6 +325,11 @@ func validatorHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return queryValidator(cliCtx, fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidator))
 }
 
+// HTTP request handler to query the voting power of a validator
+func validatorPowerHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return queryValidator(cliCtx, fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorPower))
+}
+
 // HTTP request handler to query all unbonding delegations from a validator
 func validatorDelegationsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return queryValidator(cliCtx, fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorDelegations))
@@ -28,6 +28,10 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		"/staking/redelegations",
 		postRedelegationsHandlerFn(ctx),
 	).Methods("POST")
+	r.HandleFunc(
+		"/staking/unbonding_delegations/cancel",
+		postCancelUnbondingHandlerFn(ctx),
+	).Methods("POST")
 }
 
 type (
@@ -55,6 +59,15 @@ type (
 		ValidatorAcc string       `json:"validator_acc" yaml:"validator_acc"`
 		Amount       string       `json:"amount" yaml:"amount"`
 	}
+
+	// CancelUnbondingRequest defines the properties of a cancel unbonding request's body.
+	CancelUnbondingRequest struct {
+		BaseReq        rest.BaseReq `json:"base_req" yaml:"base_req"`
+		DelegatorAcc   string       `json:"delegator_acc" yaml:"delegator_acc"`
+		ValidatorAcc   string       `json:"validator_acc" yaml:"validator_acc"`
+		Amount         string       `json:"amount" yaml:"amount"`
+		CreationHeight int64        `json:"creation_height,string" yaml:"creation_height"`
+	}
 )
 
 func postDelegationsHandlerFn(cliCtx txutil.KuCLIContext) http.HandlerFunc {
@@ -191,3 +204,46 @@ func postUnbondingDelegationsHandlerFn(cliCtx txutil.KuCLIContext) http.HandlerF
 		txutil.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+func postCancelUnbondingHandlerFn(cliCtx txutil.KuCLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CancelUnbondingRequest
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+
+		delAccountID, err := chainTypes.NewAccountIDFromStr(req.DelegatorAcc)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("delegate account id error, %v", err))
+			return
+		}
+
+		amount, err := chainTypes.ParseCoin(req.Amount)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("parse amount error, %v", err))
+			return
+		}
+
+		valAddr, err := chainTypes.NewAccountIDFromStr(req.ValidatorAcc)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("val account id error, %v", err))
+			return
+		}
+		delAccAddress, err := txutil.QueryAccountAuth(cliCtx, delAccountID)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("query account %s auth error, %v", delAccountID, err))
+			return
+		}
+
+		msg := types.NewKuMsgCancelUnbonding(delAccAddress, delAccountID, valAddr, amount, req.CreationHeight)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		txutil.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -183,7 +183,7 @@ func WriteValidators(ctx sdk.Context, keeper Keeper) (vals []tmtypes.GenesisVali
 	keeper.IterateLastValidators(ctx, func(_ int64, validator statkingexport.ValidatorI) (stop bool) {
 		vals = append(vals, tmtypes.GenesisValidator{
 			PubKey: validator.GetConsPubKey(),
-			Power:  validator.GetConsensusPower(),
+			Power:  keeper.GetLastValidatorPower(ctx, validator.GetOperatorAccountID()),
 			Name:   validator.GetMoniker(),
 		})
 
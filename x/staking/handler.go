@@ -1,6 +1,7 @@
 package staking
 
 import (
+	"strconv"
 	"time"
 
 	"github.com/KuChainNetwork/kuchain/chain/msg"
@@ -28,6 +29,8 @@ func NewHandler(k keeper.Keeper) msg.Handler {
 			return handleKuMsgRedelegate(ctx, k, msg)
 		case types.KuMsgUnbond:
 			return handleKuMsgUnbond(ctx, k, msg)
+		case types.KuMsgCancelUnbonding:
+			return handleKuMsgCancelUnbonding(ctx, k, msg)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
 		}
@@ -80,6 +83,15 @@ func handleKuMsgUnbond(ctx chainTypes.Context, k keeper.Keeper, msg types.KuMsgU
 	return handleMsgUndelegate(ctx.Context(), msgData, k)
 }
 
+func handleKuMsgCancelUnbonding(ctx chainTypes.Context, k keeper.Keeper, msg types.KuMsgCancelUnbonding) (*sdk.Result, error) {
+	msgData := types.MsgCancelUnbonding{}
+	if err := msg.UnmarshalData(Cdc(), &msgData); err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg CancelUnbonding data unmarshal error")
+	}
+	ctx.RequireAuth(msgData.DelegatorAccount)
+	return handleMsgCancelUnbonding(ctx.Context(), msgData, k)
+}
+
 // These functions assume everything has been authenticated,
 // now we just perform action and save
 
@@ -211,6 +223,10 @@ func handleMsgDelegate(ctx chainTypes.Context, msg types.MsgDelegate, k keeper.K
 		return nil, ErrBadDenom
 	}
 
+	if err := k.CheckValidatorSoftPowerCap(ctx.Context(), validator); err != nil {
+		return nil, err
+	}
+
 	// NOTE: source funds are always unbonded
 	_, err := k.Delegate(ctx.Context(), msg.DelegatorAccount, msg.Amount.Amount, stakingexport.Unbonded, validator, true)
 	if err != nil {
@@ -284,6 +300,12 @@ func handleMsgBeginRedelegate(ctx sdk.Context, msg types.MsgBeginRedelegate, k k
 		return nil, ErrBadDenom
 	}
 
+	if dstValidator, found := k.GetValidator(ctx, msg.ValidatorDstAccount); found {
+		if err := k.CheckValidatorSoftPowerCap(ctx, dstValidator); err != nil {
+			return nil, err
+		}
+	}
+
 	completionTime, err := k.BeginRedelegation(
 		ctx, msg.DelegatorAccount, msg.ValidatorSrcAccount, msg.ValidatorDstAccount, shares,
 	)
@@ -314,3 +336,33 @@ func handleMsgBeginRedelegate(ctx sdk.Context, msg types.MsgBeginRedelegate, k k
 
 	return &sdk.Result{Data: completionTimeBz, Events: ctx.EventManager().Events()}, nil
 }
+
+func handleMsgCancelUnbonding(ctx sdk.Context, msg types.MsgCancelUnbonding, k keeper.Keeper) (*sdk.Result, error) {
+	if msg.Amount.Denom != k.BondDenom(ctx) {
+		return nil, ErrBadDenom
+	}
+
+	_, err := k.CancelUnbondingDelegation(
+		ctx, msg.DelegatorAccount, msg.ValidatorAccount, msg.CreationHeight, msg.Amount.Amount,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelUnbonding,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAccount.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAccount.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCreationHeight, strconv.FormatInt(msg.CreationHeight, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAccount.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
@@ -178,6 +178,22 @@ func unbondValidator(t *testing.T, wallet *simapp.Wallet, app *simapp.SimApp, ad
 	return err
 }
 
+func cancelUnbondingValidator(t *testing.T, wallet *simapp.Wallet, app *simapp.SimApp, addAlice sdk.AccAddress, accAlice, accJack types.AccountID, amount types.Coin, creationHeight int64, passed bool) error {
+	ctxCheck := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1})
+
+	origAuthSeq, origAuthNum, err := app.AccountKeeper().GetAuthSequence(ctxCheck, addAlice)
+	So(err, ShouldBeNil)
+	msg := stakingTypes.NewKuMsgCancelUnbonding(addAlice, accAlice, accJack, amount, creationHeight)
+	fee := types.Coins{types.NewInt64Coin(constants.DefaultBondDenom, 1000000)}
+	header := abci.Header{Height: app.LastBlockHeight() + 1}
+	_, _, err = simapp.SignCheckDeliver(t, app.Codec(), app.BaseApp,
+		header, accAlice, fee,
+		[]sdk.Msg{msg}, []uint64{origAuthNum}, []uint64{origAuthSeq},
+		passed, passed, wallet.PrivKey(addAlice))
+	ctxCheck.Logger().Info("cancelUnbondingValidator error log", "err", err)
+	return err
+}
+
 func newPubKey(pk string) (res crypto.PubKey) {
 	pkBytes, err := hex.DecodeString(pk)
 	if err != nil {
@@ -344,4 +360,62 @@ func TestStakingHandler(t *testing.T) {
 		So(err, ShouldNotBeNil)
 		//
 	})
+	Convey("TestCancelUnbondingHandler", t, func() {
+		wallet := simapp.NewWallet()
+		addAlice, addJack, _, accAlice, accJack, _, app := newTestApp(wallet)
+		rightRate, _ := sdk.NewDecFromStr("0.65")
+		Newpk := newPubKey("0B485CFC0EECC619440448436F8FC9DF40566F2369E72400281454CB552AF200")
+		err := createValidator(t, wallet, app, addJack, accJack, rightRate, Newpk, true)
+		So(err, ShouldBeNil)
+
+		delegateAmount := types.NewInt64Coin(constants.DefaultBondDenom, 50000000)
+		smallAmount := types.NewInt64Coin(constants.DefaultBondDenom, 50000)
+		halfAmount := types.NewInt64Coin(constants.DefaultBondDenom, 25000)
+		err = delegationValidator(t, wallet, app, addAlice, accAlice, accJack, delegateAmount, delegateAmount, true)
+		So(err, ShouldBeNil)
+
+		//alice U jack 50000
+		err = unbondValidator(t, wallet, app, addAlice, accAlice, accJack, smallAmount, true)
+		So(err, ShouldBeNil)
+
+		ctx := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1})
+		ubd, found := app.StakeKeeper().GetUnbondingDelegation(ctx, accAlice, accJack)
+		So(found, ShouldBeTrue)
+		So(len(ubd.Entries), ShouldEqual, 1)
+		creationHeight := ubd.Entries[0].CreationHeight
+		delegation, found := app.StakeKeeper().GetDelegation(ctx, accAlice, accJack)
+		So(found, ShouldBeTrue)
+		shares := delegation.Shares
+
+		// no entry at the height
+		err = cancelUnbondingValidator(t, wallet, app, addAlice, accAlice, accJack, halfAmount, creationHeight+100, false)
+		So(err, ShouldNotBeNil)
+		// amount exceeds the entry balance
+		err = cancelUnbondingValidator(t, wallet, app, addAlice, accAlice, accJack, delegateAmount, creationHeight, false)
+		So(err, ShouldNotBeNil)
+
+		// cancel half
+		err = cancelUnbondingValidator(t, wallet, app, addAlice, accAlice, accJack, halfAmount, creationHeight, true)
+		So(err, ShouldBeNil)
+
+		ctx = app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1})
+		ubd, found = app.StakeKeeper().GetUnbondingDelegation(ctx, accAlice, accJack)
+		So(found, ShouldBeTrue)
+		So(ubd.Entries[0].Balance, ShouldEqual, halfAmount.Amount)
+		delegation, found = app.StakeKeeper().GetDelegation(ctx, accAlice, accJack)
+		So(found, ShouldBeTrue)
+		So(delegation.Shares.GT(shares), ShouldBeTrue)
+
+		// cancel all the rest, the unbonding delegation and its queue item are removed
+		err = cancelUnbondingValidator(t, wallet, app, addAlice, accAlice, accJack, halfAmount, creationHeight, true)
+		So(err, ShouldBeNil)
+
+		ctx = app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1})
+		_, found = app.StakeKeeper().GetUnbondingDelegation(ctx, accAlice, accJack)
+		So(found, ShouldBeFalse)
+		So(len(app.StakeKeeper().GetUBDQueueTimeSlice(ctx, ubd.Entries[0].CompletionTime)), ShouldEqual, 0)
+
+		err = cancelUnbondingValidator(t, wallet, app, addAlice, accAlice, accJack, halfAmount, creationHeight, false)
+		So(err, ShouldNotBeNil)
+	})
 }
@@ -262,6 +262,26 @@ func (k Keeper) UBDQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterato
 		sdk.InclusiveEndBytes(types.GetUnbondingDelegationTimeKey(endTime)))
 }
 
+// RemoveUBDQueue removes one (delegator, validator) pair from the unbonding
+// queue timeslice at completionTime, used when an unbonding entry is canceled
+func (k Keeper) RemoveUBDQueue(ctx sdk.Context, ubd types.UnbondingDelegation,
+	completionTime time.Time) {
+
+	timeSlice := k.GetUBDQueueTimeSlice(ctx, completionTime)
+	for i, dvPair := range timeSlice {
+		if dvPair.DelegatorAccount.Eq(ubd.DelegatorAccount) && dvPair.ValidatorAccount.Eq(ubd.ValidatorAccount) {
+			timeSlice = append(timeSlice[:i], timeSlice[i+1:]...)
+			break
+		}
+	}
+
+	if len(timeSlice) == 0 {
+		ctx.KVStore(k.storeKey).Delete(types.GetUnbondingDelegationTimeKey(completionTime))
+	} else {
+		k.SetUBDQueueTimeSlice(ctx, completionTime, timeSlice)
+	}
+}
+
 // Returns a concatenated list of all the timeslices inclusively previous to
 // currTime, and deletes the timeslices from the queue
 func (k Keeper) DequeueAllMatureUBDQueue(ctx sdk.Context, currTime time.Time) (matureUnbonds []types.DVPair) {
@@ -300,6 +320,17 @@ func (k Keeper) GetRedelegations(ctx sdk.Context, delegator AccountID,
 	return redelegations[:i] // trim if the array length < maxRetrieve
 }
 
+// IsAccountStaking returns if the account is a validator or has any delegation, unbonding delegation or redelegation
+func (k Keeper) IsAccountStaking(ctx sdk.Context, account AccountID) bool {
+	if _, found := k.GetValidator(ctx, account); found {
+		return true
+	}
+
+	return len(k.GetDelegatorDelegations(ctx, account, 1)) > 0 ||
+		len(k.GetUnbondingDelegations(ctx, account, 1)) > 0 ||
+		len(k.GetRedelegations(ctx, account, 1)) > 0
+}
+
 // return a redelegation
 func (k Keeper) GetRedelegation(ctx sdk.Context,
 	delAddr AccountID, valSrcAddr, valDstAddr AccountID) (red types.Redelegation, found bool) {
@@ -685,6 +716,68 @@ func (k Keeper) Undelegate(
 	return completionTime, nil
 }
 
+// CancelUnbondingDelegation cancels amount of the unbonding delegation entry
+// created at creationHeight and delegates it back to the original validator.
+func (k Keeper) CancelUnbondingDelegation(
+	ctx sdk.Context, delAddr AccountID, valAddr AccountID, creationHeight int64, amount sdk.Int,
+) (newShares sdk.Dec, err error) {
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.ZeroDec(), types.ErrNoValidatorFound
+	}
+
+	if validator.IsJailed() {
+		return sdk.ZeroDec(), types.ErrValidatorJailed
+	}
+
+	ubd, found := k.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return sdk.ZeroDec(), types.ErrNoUnbondingDelegation
+	}
+
+	entryIdx := -1
+	for i, entry := range ubd.Entries {
+		if entry.CreationHeight == creationHeight && !entry.IsMature(ctx.BlockHeader().Time) {
+			entryIdx = i
+			break
+		}
+	}
+
+	if entryIdx < 0 {
+		return sdk.ZeroDec(), types.ErrNoUnbondingDelegationEntry
+	}
+
+	entry := ubd.Entries[entryIdx]
+	if amount.GT(entry.Balance) {
+		return sdk.ZeroDec(), types.ErrBadCancelUnbondingAmount
+	}
+
+	// the coins of unbonding entry are in the not bonded pool
+	newShares, err = k.Delegate(ctx, delAddr, amount, stakingexport.Unbonding, validator, false)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	if amount.Equal(entry.Balance) {
+		ubd.RemoveEntry(int64(entryIdx))
+		k.RemoveUBDQueue(ctx, ubd, entry.CompletionTime)
+	} else {
+		entry.Balance = entry.Balance.Sub(amount)
+		entry.InitialBalance = entry.InitialBalance.Sub(amount)
+		ubd.Entries[entryIdx] = entry
+	}
+
+	// set the unbonding delegation or remove it if there are no more entries
+	if len(ubd.Entries) == 0 {
+		k.RemoveUnbondingDelegation(ctx, ubd)
+	} else {
+		k.SetUnbondingDelegation(ctx, ubd)
+	}
+
+	return newShares, nil
+}
+
 // CompleteUnbonding completes the unbonding of all mature entries in the
 // retrieved unbonding delegation object and returns the total unbonding balance
 // or an error upon failure.
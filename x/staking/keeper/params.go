@@ -50,6 +50,19 @@ func (k Keeper) BondDenom(ctx sdk.Context) (res string) {
 	return
 }
 
+// MaxValidatorPowerRatio - the cap of a validator voting power to total power
+func (k Keeper) MaxValidatorPowerRatio(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyMaxValidatorPowerRatio, &res)
+	return
+}
+
+// SoftValidatorPowerRatio - the ratio of a validator voting power to total power
+// over which delegations to the validator are rejected
+func (k Keeper) SoftValidatorPowerRatio(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeySoftValidatorPowerRatio, &res)
+	return
+}
+
 // Get all parameteras as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return types.NewParams(
@@ -58,6 +71,8 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.MaxEntries(ctx),
 		k.HistoricalEntries(ctx),
 		k.BondDenom(ctx),
+		k.MaxValidatorPowerRatio(ctx),
+		k.SoftValidatorPowerRatio(ctx),
 	)
 }
 
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/staking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetValidatorPowerRecord gets the power record of validator in effect at height,
+// which is the latest one recorded at or before the height
+func (k Keeper) GetValidatorPowerRecord(ctx sdk.Context, operator types.AccountID, height int64) (types.ValidatorPowerRecord, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.ReverseIterator(
+		types.GetValidatorPowerRecordsKey(operator),
+		sdk.PrefixEndBytes(types.GetValidatorPowerRecordKey(operator, height)))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return types.ValidatorPowerRecord{}, false
+	}
+
+	var record types.ValidatorPowerRecord
+	k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &record)
+
+	return record, true
+}
+
+// SetValidatorPowerRecord sets the power record of validator at height
+func (k Keeper) SetValidatorPowerRecord(ctx sdk.Context, operator types.AccountID, height int64, record types.ValidatorPowerRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValidatorPowerRecordKey(operator, height), k.cdc.MustMarshalBinaryBare(record))
+}
+
+// DeleteValidatorPowerRecords deletes all the power records of validator
+func (k Keeper) DeleteValidatorPowerRecords(ctx sdk.Context, operator types.AccountID) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetValidatorPowerRecordsKey(operator))
+	defer iterator.Close()
+
+	keys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// trackValidatorPowerRecord records the power sent to Tendermint and the real tokens of a bonded
+// validator while its power is capped, and once more when it is no longer capped, so Slash can
+// find the real tokens at the infraction height. The records superseded for longer than the
+// unbonding time are pruned, as no infraction can be slashed at their heights.
+func (k Keeper) trackValidatorPowerRecord(ctx sdk.Context, validator types.Validator, power int64) {
+	operator := validator.OperatorAccount
+	record := types.NewValidatorPowerRecord(power, validator.Tokens, ctx.BlockHeader().Time)
+
+	last, found := k.GetValidatorPowerRecord(ctx, operator, ctx.BlockHeight())
+	if !record.IsCapped() && (!found || !last.IsCapped()) {
+		return
+	}
+
+	if found && last.Power == record.Power && last.Tokens.Equal(record.Tokens) {
+		return
+	}
+
+	k.SetValidatorPowerRecord(ctx, operator, ctx.BlockHeight(), record)
+	k.pruneValidatorPowerRecords(ctx, operator)
+}
+
+// pruneValidatorPowerRecords deletes the records of validator superseded for longer than the unbonding time
+func (k Keeper) pruneValidatorPowerRecords(ctx sdk.Context, operator types.AccountID) {
+	store := ctx.KVStore(k.storeKey)
+	expired := ctx.BlockHeader().Time.Add(-k.UnbondingTime(ctx))
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetValidatorPowerRecordsKey(operator))
+	defer iterator.Close()
+
+	keys := make([][]byte, 0)
+	var prevKey []byte
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.ValidatorPowerRecord
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &record)
+
+		if !record.Time.Before(expired) {
+			break
+		}
+
+		if prevKey != nil {
+			keys = append(keys, prevKey)
+		}
+		prevKey = iterator.Key()
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
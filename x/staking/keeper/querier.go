@@ -58,6 +58,9 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QueryParameters:
 			return queryParameters(ctx, k)
 
+		case types.QueryValidatorPower:
+			return queryValidatorPower(ctx, req, k)
+
 		case types.QueryValidatorByConsAddr:
 			return queryValidatorFromConsAddr(ctx, req, k)
 
@@ -120,6 +123,34 @@ func queryValidator(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, e
 	return res, nil
 }
 
+func queryValidatorPower(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryValidatorParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	validator, found := k.GetValidator(ctx, params.ValidatorAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	power := types.NewValidatorPower(
+		validator.OperatorAccount,
+		validator.PotentialConsensusPower(),
+		k.GetLastValidatorPower(ctx, validator.OperatorAccount),
+		k.GetLastTotalPower(ctx),
+	)
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, power)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
 func queryValidatorDelegations(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
 	var params types.QueryValidatorParams
 
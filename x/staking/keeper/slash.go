@@ -29,11 +29,6 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 		panic(fmt.Errorf("attempted to slash with a negative slash factor: %v", slashFactor))
 	}
 
-	// Amount of slashing = slash slashFactor * power at time of infraction
-	amount := stakingexport.TokensFromConsensusPower(power)
-	slashAmountDec := amount.ToDec().Mul(slashFactor)
-	slashAmount := slashAmountDec.TruncateInt()
-
 	// ref https://github.com/cosmos/cosmos-sdk/issues/1348
 
 	validator, found := k.GetValidatorByConsAddr(ctx, consAddr)
@@ -55,6 +50,19 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 
 	operatorAccount := validator.GetOperatorAccountID()
 
+	// Amount of slashing = slash slashFactor * power at time of infraction
+	amount := stakingexport.TokensFromConsensusPower(power)
+
+	// The power from Tendermint is capped by MaxValidatorPowerRatio, so a validator
+	// capped at the infraction height is slashed by its real tokens at that height.
+	if record, found := k.GetValidatorPowerRecord(ctx, operatorAccount, infractionHeight); found &&
+		record.IsCapped() && record.Power == power {
+		amount = record.Tokens
+	}
+
+	slashAmountDec := amount.ToDec().Mul(slashFactor)
+	slashAmount := slashAmountDec.TruncateInt()
+
 	// call the before-modification hook
 	k.BeforeValidatorModified(ctx, operatorAccount)
 
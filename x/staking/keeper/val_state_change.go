@@ -97,6 +97,9 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 	// (see LastValidatorPowerKey).
 	last := k.getLastValidatorsByAddr(ctx)
 
+	// validators becoming or already a part of the bonded validator set
+	bonded := make([]types.Validator, 0, maxValidators)
+
 	// Iterate over validators, highest power to lowest.
 	iterator := k.ValidatorsPowerStoreIterator(ctx)
 	defer iterator.Close()
@@ -133,23 +136,40 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 			panic("unexpected validator status")
 		}
 
+		bonded = append(bonded, validator)
+		count++
+	}
+
+	// cap the voting power of each validator, the effective power is used as the consensus power
+	powers := make([]int64, 0, len(bonded))
+	for _, validator := range bonded {
+		powers = append(powers, validator.ConsensusPower())
+	}
+	powers = CapValidatorPowers(powers, k.MaxValidatorPowerRatio(ctx))
+
+	for i, validator := range bonded {
+		valAccount := validator.OperatorAccount
+
 		// fetch the old power bytes
 		var valAddrBytes [types.AccountIDlen]byte
 		copy(valAddrBytes[:], valAccount.Value[:])
 		oldPowerBytes, found := last[valAddrBytes]
 
-		newPower := validator.ConsensusPower()
+		newPower := powers[i]
 		newPowerBytes := k.cdc.MustMarshalBinaryBare(&gogotypes.Int64Value{Value: newPower})
 
 		// update the validator set if power has changed
 		if !found || !bytes.Equal(oldPowerBytes, newPowerBytes) {
-			updates = append(updates, validator.ABCIValidatorUpdate())
+			update := validator.ABCIValidatorUpdate()
+			update.Power = newPower
+			updates = append(updates, update)
 			k.SetLastValidatorPower(ctx, valAccount, newPower)
 		}
 
+		k.trackValidatorPowerRecord(ctx, validator, newPower)
+
 		delete(last, valAddrBytes)
 
-		count++
 		totalPower = totalPower.Add(NewInt(newPower))
 	}
 
@@ -186,6 +206,64 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 	return updates
 }
 
+// CapValidatorPowers caps each power so that no one exceeds ratio of the total capped powers,
+// the capped ones get the same power. If the cap cannot be satisfied, such as the ratio is
+// less than 1/len(powers), all powers are capped to the minimum one. Zero ratio means no cap.
+func CapValidatorPowers(powers []int64, ratio sdk.Dec) []int64 {
+	res := make([]int64, len(powers))
+	copy(res, powers)
+
+	if ratio.IsNil() || !ratio.IsPositive() || len(powers) == 0 {
+		return res
+	}
+
+	// indexes of powers from highest to lowest
+	idxs := make([]int, len(powers))
+	for i := range idxs {
+		idxs[i] = i
+	}
+	sort.SliceStable(idxs, func(i, j int) bool {
+		return powers[idxs[i]] > powers[idxs[j]]
+	})
+
+	// uncapped[k] is the sum of powers not in the top k
+	uncapped := make([]sdk.Dec, len(powers)+1)
+	uncapped[len(powers)] = sdk.ZeroDec()
+	for k := len(powers) - 1; k >= 0; k-- {
+		uncapped[k] = uncapped[k+1].Add(sdk.NewDec(powers[idxs[k]]))
+	}
+
+	// the capped power c of the top k validators satisfies c = ratio * (uncapped[k] + k*c)
+	capPower := powers[idxs[len(idxs)-1]]
+	for k := 0; k < len(idxs); k++ {
+		denom := sdk.OneDec().Sub(ratio.MulInt64(int64(k)))
+		if !denom.IsPositive() {
+			break
+		}
+
+		c := ratio.Mul(uncapped[k]).Quo(denom)
+		if sdk.NewDec(powers[idxs[k]]).LTE(c) {
+			if k == 0 {
+				return res
+			}
+			capPower = c.TruncateInt64()
+			break
+		}
+	}
+
+	if capPower < 1 {
+		capPower = 1
+	}
+
+	for i := range res {
+		if res[i] > capPower {
+			res[i] = capPower
+		}
+	}
+
+	return res
+}
+
 // Validator state transitions
 
 func (k Keeper) bondedToUnbonding(ctx sdk.Context, validator types.Validator) types.Validator {
@@ -9,6 +9,7 @@ import (
 
 	"github.com/KuChainNetwork/kuchain/x/staking/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // Cache the amino decoding of validators, as it can be the case that repeated slashing calls
@@ -206,6 +207,7 @@ func (k Keeper) RemoveValidator(ctx sdk.Context, address types.AccountID) {
 	store.Delete(types.GetValidatorKey(address))
 	store.Delete(types.GetValidatorByConsAddrKey(valConsAddr))
 	store.Delete(types.GetValidatorsByPowerIndexKey(validator))
+	k.DeleteValidatorPowerRecords(ctx, address)
 
 	// call hooks
 	k.AfterValidatorRemoved(ctx, validator.GetConsAccount(), validator.OperatorAccount)
@@ -459,3 +461,37 @@ func (k Keeper) UnbondAllMatureValidatorQueue(ctx sdk.Context) {
 		store.Delete(validatorTimesliceIterator.Key())
 	}
 }
+
+// CheckValidatorSoftPowerCap returns error if the voting power of the validator
+// already exceeds the soft cap, so that it cannot accept more delegations. The
+// powers are by the real tokens, as the consensus powers may be capped.
+func (k Keeper) CheckValidatorSoftPowerCap(ctx sdk.Context, validator types.Validator) error {
+	ratio := k.SoftValidatorPowerRatio(ctx)
+	if ratio.IsNil() || !ratio.IsPositive() {
+		return nil
+	}
+
+	power := validator.PotentialConsensusPower()
+	totalPower := sdk.ZeroInt()
+	inLastValidators := false
+	for _, val := range k.GetLastValidators(ctx) {
+		totalPower = totalPower.AddRaw(val.PotentialConsensusPower())
+		if val.OperatorAccount.Eq(validator.OperatorAccount) {
+			inLastValidators = true
+		}
+	}
+	if !inLastValidators {
+		totalPower = totalPower.AddRaw(power)
+	}
+
+	if !totalPower.IsPositive() {
+		return nil
+	}
+
+	if sdk.NewDec(power).GT(ratio.MulInt(totalPower)) {
+		return sdkerrors.Wrapf(types.ErrValidatorPowerExceedsSoftCap,
+			"validator %s power %d, total power %s", validator.OperatorAccount, power, totalPower)
+	}
+
+	return nil
+}
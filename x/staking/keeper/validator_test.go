@@ -7,6 +7,7 @@ import (
 	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
 	"github.com/KuChainNetwork/kuchain/test/simapp"
 	"github.com/KuChainNetwork/kuchain/x/staking/exported"
+	"github.com/KuChainNetwork/kuchain/x/staking/keeper"
 	"github.com/KuChainNetwork/kuchain/x/staking/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	. "github.com/smartystreets/goconvey/convey"
@@ -1178,3 +1179,103 @@ func TestValidator(t *testing.T) {
 		}
 	})
 }
+
+func TestCapValidatorPowers(t *testing.T) {
+	Convey("TestCapValidatorPowers", t, func() {
+		// no cap
+		So(keeper.CapValidatorPowers([]int64{100, 10, 10}, sdk.ZeroDec()), ShouldResemble, []int64{100, 10, 10})
+		// all under the cap
+		So(keeper.CapValidatorPowers([]int64{30, 40, 30}, sdk.NewDecWithPrec(5, 1)), ShouldResemble, []int64{30, 40, 30})
+		// top one capped
+		So(keeper.CapValidatorPowers([]int64{10, 100, 10}, sdk.NewDecWithPrec(4, 1)), ShouldResemble, []int64{10, 13, 10})
+		// top two capped
+		So(keeper.CapValidatorPowers([]int64{100, 90, 10, 10, 10}, sdk.NewDecWithPrec(3, 1)), ShouldResemble, []int64{22, 22, 10, 10, 10})
+		// cap cannot be satisfied
+		So(keeper.CapValidatorPowers([]int64{100, 50, 20}, sdk.NewDecWithPrec(2, 1)), ShouldResemble, []int64{20, 20, 20})
+	})
+}
+
+func TestValidatorPowerCap(t *testing.T) {
+	wallet := simapp.NewWallet()
+	Convey("TestValidatorPowerCap", t, func() {
+		_, _, _, _, _, _, app := NewTestApp(wallet)
+		k := app.StakeKeeper()
+		ctx := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1})
+
+		params := k.GetParams(ctx)
+		params.MaxValidatorPowerRatio = sdk.NewDecWithPrec(4, 1)
+		params.SoftValidatorPowerRatio = sdk.NewDecWithPrec(35, 2)
+		k.SetParams(ctx, params)
+
+		powers := []int64{100, 10, 10}
+		var validators [3]types.Validator
+		notBondedPool := k.GetNotBondedPool(ctx)
+		for i, power := range powers {
+			tokens := exported.TokensFromConsensusPower(power)
+			validators[i] = types.NewValidator(Accd[i], PKs[i], types.Description{})
+			validators[i], _ = validators[i].AddTokensFromDel(tokens)
+			app.AssetKeeper().IssueCoinPower(ctx, notBondedPool.GetID(), chainTypes.NewCoins(chainTypes.NewCoin(k.BondDenom(ctx), tokens)))
+			k.SetValidator(ctx, validators[i])
+			k.SetValidatorByConsAddr(ctx, validators[i])
+			k.SetValidatorByPowerIndex(ctx, validators[i])
+			k.AfterValidatorCreated(ctx, Accd[i])
+		}
+
+		k.ApplyAndReturnValidatorSetUpdates(ctx)
+
+		totalPower := k.GetLastTotalPower(ctx)
+		topPower := k.GetLastValidatorPower(ctx, Accd[0])
+		So(topPower < powers[0], ShouldBeTrue)
+		So(sdk.NewDec(topPower).LTE(params.MaxValidatorPowerRatio.MulInt(totalPower)), ShouldBeTrue)
+		So(k.GetLastValidatorPower(ctx, Accd[1]), ShouldEqual, powers[1])
+
+		// the top validator exceeds the soft cap
+		validator, found := k.GetValidator(ctx, Accd[0])
+		So(found, ShouldBeTrue)
+		So(k.CheckValidatorSoftPowerCap(ctx, validator), ShouldNotBeNil)
+
+		validator, found = k.GetValidator(ctx, Accd[1])
+		So(found, ShouldBeTrue)
+		So(k.CheckValidatorSoftPowerCap(ctx, validator), ShouldBeNil)
+
+		// the capped validator is slashed by its real tokens, not the capped power from Tendermint
+		validator, _ = k.GetValidator(ctx, Accd[0])
+		fraction := sdk.NewDecWithPrec(1, 1)
+		k.Slash(ctx, validator.GetConsAddr(), ctx.BlockHeight(), topPower, fraction)
+
+		slashed, _ := k.GetValidator(ctx, Accd[0])
+		So(slashed.GetTokens(), ShouldResemble, validator.GetTokens().Sub(validator.GetTokens().ToDec().Mul(fraction).TruncateInt()))
+
+		// the real tokens are recorded by height while capped
+		k.ApplyAndReturnValidatorSetUpdates(ctx)
+		infractionHeight := ctx.BlockHeight()
+		record, found := k.GetValidatorPowerRecord(ctx, Accd[0], infractionHeight)
+		So(found, ShouldBeTrue)
+		So(record.IsCapped(), ShouldBeTrue)
+		So(record.Tokens, ShouldResemble, slashed.GetTokens())
+
+		_, found = k.GetValidatorPowerRecord(ctx, Accd[1], infractionHeight)
+		So(found, ShouldBeFalse)
+
+		// tokens delegated after the infraction are not slashed
+		ctx = ctx.WithBlockHeight(infractionHeight + 1)
+		delTokens := exported.TokensFromConsensusPower(50)
+		for _, acc := range Accd[:2] {
+			validator, _ = k.GetValidator(ctx, acc)
+			app.AssetKeeper().IssueCoinPower(ctx, k.GetBondedPool(ctx).GetID(), chainTypes.NewCoins(chainTypes.NewCoin(k.BondDenom(ctx), delTokens)))
+			k.AddValidatorTokensAndShares(ctx, validator, delTokens)
+		}
+
+		validator, _ = k.GetValidator(ctx, Accd[0])
+		k.Slash(ctx, validator.GetConsAddr(), infractionHeight, record.Power, fraction)
+		slashed, _ = k.GetValidator(ctx, Accd[0])
+		So(slashed.GetTokens(), ShouldResemble, validator.GetTokens().Sub(record.Tokens.ToDec().Mul(fraction).TruncateInt()))
+
+		// uncapped validator is slashed by the power from Tendermint
+		validator, _ = k.GetValidator(ctx, Accd[1])
+		k.Slash(ctx, validator.GetConsAddr(), infractionHeight, powers[1], fraction)
+		slashed, _ = k.GetValidator(ctx, Accd[1])
+		So(slashed.GetTokens(), ShouldResemble,
+			validator.GetTokens().Sub(exported.TokensFromConsensusPower(powers[1]).ToDec().Mul(fraction).TruncateInt()))
+	})
+}
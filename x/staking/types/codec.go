@@ -12,12 +12,14 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(&MsgDelegate{}, "kuchain/MsgDelegate", nil)
 	cdc.RegisterConcrete(&MsgUndelegate{}, "kuchain/MsgUndelegate", nil)
 	cdc.RegisterConcrete(&MsgBeginRedelegate{}, "kuchain/MsgBeginRedelegate", nil)
+	cdc.RegisterConcrete(&MsgCancelUnbonding{}, "kuchain/MsgCancelUnbonding", nil)
 
 	cdc.RegisterConcrete(KuMsgCreateValidator{}, "kuchain/KuMsgCreateValidator", nil)
 	cdc.RegisterConcrete(KuMsgDelegate{}, "kuchain/KuMsgDelegate", nil)
 	cdc.RegisterConcrete(KuMsgEditValidator{}, "kuchain/KuMsgEditValidator", nil)
 	cdc.RegisterConcrete(KuMsgRedelegate{}, "kuchain/KuMsgRedelegate", nil)
 	cdc.RegisterConcrete(KuMsgUnbond{}, "kuchain/KuMsgUnbond", nil)
+	cdc.RegisterConcrete(KuMsgCancelUnbonding{}, "kuchain/KuMsgCancelUnbonding", nil)
 }
 
 var (
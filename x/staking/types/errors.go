@@ -58,4 +58,8 @@ var (
 	ErrNoHistoricalInfo                = sdkerrors.Register(ModuleName, 46, "no historical info found")
 	ErrEmptyValidatorPubKey            = sdkerrors.Register(ModuleName, 47, "empty validator public key")
 	ErrUnKnowAccount                   = sdkerrors.Register(ModuleName, 48, "validator operator is not a known account")
+	ErrNoUnbondingDelegationEntry      = sdkerrors.Register(ModuleName, 49, "no unbonding delegation entry found at the creation height")
+	ErrBadCancelUnbondingAmount        = sdkerrors.Register(ModuleName, 50, "cancel unbonding amount exceeds the unbonding delegation entry balance")
+	ErrBadCancelUnbondingHeight        = sdkerrors.Register(ModuleName, 51, "invalid unbonding delegation entry creation height")
+	ErrValidatorPowerExceedsSoftCap    = sdkerrors.Register(ModuleName, 52, "validator voting power exceeds the soft cap, cannot accept more delegations")
 )
@@ -39,6 +39,8 @@ var (
 	LastValidatorPowerKey = []byte{0x11} // prefix for each key to a validator index, for bonded validators
 	LastTotalPowerKey     = []byte{0x12} // prefix for the total power
 
+	ValidatorPowerRecordKey = []byte{0x13} // prefix for the records of capped validator powers by height
+
 	ValidatorsKey             = []byte{0x21} // prefix for each key to a validator
 	ValidatorsByConsAddrKey   = []byte{0x22} // prefix for each key to a validator index, by pubkey
 	ValidatorsByPowerIndexKey = []byte{0x23} // prefix for each key to a validator index, sorted by power
@@ -93,6 +95,17 @@ func GetLastValidatorPowerKey(operator AccountID) []byte {
 	return append(LastValidatorPowerKey, operator.StoreKey()...)
 }
 
+// gets the prefix for the power records of a validator
+func GetValidatorPowerRecordsKey(operator AccountID) []byte {
+	return append(ValidatorPowerRecordKey, operator.StoreKey()...)
+}
+
+// gets the key for the power record of a validator at height
+// VALUE: staking/ValidatorPowerRecord
+func GetValidatorPowerRecordKey(operator AccountID, height int64) []byte {
+	return append(GetValidatorPowerRecordsKey(operator), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
 // get the power ranking of a validator
 // NOTE the larger values are of higher value
 func getValidatorPowerRank(validator Validator) []byte {
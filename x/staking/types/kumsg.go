@@ -177,3 +177,34 @@ func (msg KuMsgUnbond) ValidateBasic() error {
 	}
 	return msgData.ValidateBasic()
 }
+
+type KuMsgCancelUnbonding struct {
+	chainTypes.KuMsg
+}
+
+func NewKuMsgCancelUnbonding(auth sdk.AccAddress, delAddr chainTypes.AccountID, valAddr chainTypes.AccountID, amount chainTypes.Coin, creationHeight int64) KuMsgCancelUnbonding {
+
+	return KuMsgCancelUnbonding{
+		*msg.MustNewKuMsg(
+			RouterKeyName,
+			msg.WithAuth(auth),
+			msg.WithData(Cdc(), &MsgCancelUnbonding{
+				DelegatorAccount: delAddr,
+				ValidatorAccount: valAddr,
+				Amount:           amount,
+				CreationHeight:   creationHeight,
+			}),
+		),
+	}
+}
+
+func (msg KuMsgCancelUnbonding) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateTransfer(); err != nil {
+		return err
+	}
+	msgData := MsgCancelUnbonding{}
+	if err := msg.UnmarshalData(Cdc(), &msgData); err != nil {
+		return err
+	}
+	return msgData.ValidateBasic()
+}
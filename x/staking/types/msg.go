@@ -7,7 +7,7 @@ import (
 	"github.com/tendermint/tendermint/crypto"
 )
 
-var _, _, _, _, _ chainTypes.KuMsgData = (*MsgCreateValidator)(nil), (*MsgEditValidator)(nil), (*MsgDelegate)(nil), (*MsgBeginRedelegate)(nil), (*MsgUndelegate)(nil)
+var _, _, _, _, _, _ chainTypes.KuMsgData = (*MsgCreateValidator)(nil), (*MsgEditValidator)(nil), (*MsgDelegate)(nil), (*MsgBeginRedelegate)(nil), (*MsgUndelegate)(nil), (*MsgCancelUnbonding)(nil)
 
 // MsgCreateValidator defines an SDK message for creating a new validator.
 type MsgCreateValidator struct {
@@ -318,3 +318,61 @@ func (msg MsgUndelegate) ValidateBasic() error {
 	}
 	return nil
 }
+
+// MsgCancelUnbonding defines an SDK message for canceling an unbonding delegation
+// entry and delegating the coins back to the original validator.
+type MsgCancelUnbonding struct {
+	DelegatorAccount AccountID `json:"delegator_account" yaml:"delegator_account"`
+	ValidatorAccount AccountID `json:"validator_account" yaml:"validator_account"`
+	Amount           Coin      `json:"amount" yaml:"amount"`
+	CreationHeight   int64     `json:"creation_height" yaml:"creation_height"`
+}
+
+// NewMsgCancelUnbonding creates a new MsgCancelUnbonding instance.
+func NewMsgCancelUnbonding(delAddr chainTypes.AccountID, valAddr chainTypes.AccountID, amount chainTypes.Coin, creationHeight int64) MsgCancelUnbonding {
+	return MsgCancelUnbonding{
+		DelegatorAccount: delAddr,
+		ValidatorAccount: valAddr,
+		Amount:           amount,
+		CreationHeight:   creationHeight,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgCancelUnbonding) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (MsgCancelUnbonding) Type() chainTypes.Name { return chainTypes.MustName("cancelunbonding") }
+
+func (msg MsgCancelUnbonding) Sender() AccountID {
+	return msg.DelegatorAccount
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgCancelUnbonding) GetSigners() []sdk.AccAddress {
+	delegatorAccAddress, _ := msg.DelegatorAccount.ToAccAddress()
+	return []sdk.AccAddress{delegatorAccAddress}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgCancelUnbonding) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCancelUnbonding) ValidateBasic() error {
+	if msg.DelegatorAccount.Empty() {
+		return ErrEmptyDelegatorAddr
+	}
+	if msg.ValidatorAccount.Empty() {
+		return ErrEmptyValidatorAddr
+	}
+	if !msg.Amount.Amount.IsPositive() {
+		return ErrBadSharesAmount
+	}
+	if msg.CreationHeight < 0 {
+		return ErrBadCancelUnbondingHeight
+	}
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	stakingexport "github.com/KuChainNetwork/kuchain/x/staking/exported"
 	"github.com/KuChainNetwork/kuchain/x/staking/external"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	yaml "gopkg.in/yaml.v2"
 )
 
@@ -27,9 +28,15 @@ const (
 	// Default maximum entries in a UBD/RED pair
 	DefaultMaxEntries uint32 = 7
 
-	// DefaultHistorical entries is 0 since it must only be non-zero for
-	// IBC connected chains
-	DefaultHistoricalEntries uint32 = 0
+	// DefaultHistorical entries covers the max age of submitted evidence, the
+	// evidence module verifies light client attacks and plugin reports by them
+	DefaultHistoricalEntries uint32 = 1000
+)
+
+// Default voting power caps, zero means no cap
+var (
+	DefaultMaxValidatorPowerRatio  = sdk.ZeroDec()
+	DefaultSoftValidatorPowerRatio = sdk.ZeroDec()
 )
 
 // nolint - Keys for parameter access
@@ -39,6 +46,9 @@ var (
 	KeyMaxEntries        = []byte("KeyMaxEntries")
 	KeyBondDenom         = []byte("BondDenom")
 	KeyHistoricalEntries = []byte("HistoricalEntries")
+
+	KeyMaxValidatorPowerRatio  = []byte("MaxValidatorPowerRatio")
+	KeySoftValidatorPowerRatio = []byte("SoftValidatorPowerRatio")
 )
 
 var _ external.ParamsSet = (*Params)(nil)
@@ -50,19 +60,29 @@ type Params struct {
 	MaxEntries        uint32        `json:"max_entries,omitempty" yaml:"max_entries"`
 	HistoricalEntries uint32        `json:"historical_entries,omitempty" yaml:"historical_entries"`
 	BondDenom         string        `json:"bond_denom,omitempty" yaml:"bond_denom"`
+
+	// MaxValidatorPowerRatio caps the voting power of a validator to the ratio of total voting power,
+	// the tokens over the cap are still bonded but not count into consensus power, zero means no cap.
+	MaxValidatorPowerRatio sdk.Dec `json:"max_validator_power_ratio" yaml:"max_validator_power_ratio"`
+	// SoftValidatorPowerRatio rejects delegations to the validator whose voting power already exceeds
+	// the ratio of total voting power, zero means no cap.
+	SoftValidatorPowerRatio sdk.Dec `json:"soft_validator_power_ratio" yaml:"soft_validator_power_ratio"`
 }
 
 // NewParams creates a new Params instance
 func NewParams(
 	unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string,
+	maxValidatorPowerRatio, softValidatorPowerRatio sdk.Dec,
 ) Params {
 
 	return Params{
-		UnbondingTime:     unbondingTime,
-		MaxValidators:     maxValidators,
-		MaxEntries:        maxEntries,
-		HistoricalEntries: historicalEntries,
-		BondDenom:         bondDenom,
+		UnbondingTime:           unbondingTime,
+		MaxValidators:           maxValidators,
+		MaxEntries:              maxEntries,
+		HistoricalEntries:       historicalEntries,
+		BondDenom:               bondDenom,
+		MaxValidatorPowerRatio:  maxValidatorPowerRatio,
+		SoftValidatorPowerRatio: softValidatorPowerRatio,
 	}
 }
 
@@ -74,6 +94,8 @@ func (p *Params) ParamSetPairs() external.ParamsSetPairs {
 		external.NewParamSetPair(KeyMaxEntries, &p.MaxEntries, validateMaxEntries),
 		external.NewParamSetPair(KeyHistoricalEntries, &p.HistoricalEntries, validateHistoricalEntries),
 		external.NewParamSetPair(KeyBondDenom, &p.BondDenom, validateBondDenom),
+		external.NewParamSetPair(KeyMaxValidatorPowerRatio, &p.MaxValidatorPowerRatio, validatePowerRatio),
+		external.NewParamSetPair(KeySoftValidatorPowerRatio, &p.SoftValidatorPowerRatio, validatePowerRatio),
 	}
 }
 
@@ -85,6 +107,8 @@ func DefaultParams() Params {
 		DefaultMaxEntries,
 		DefaultHistoricalEntries,
 		stakingexport.DefaultBondDenom,
+		DefaultMaxValidatorPowerRatio,
+		DefaultSoftValidatorPowerRatio,
 	)
 }
 
@@ -126,6 +150,12 @@ func (p Params) Validate() error {
 	if err := validateBondDenom(p.BondDenom); err != nil {
 		return err
 	}
+	if err := validatePowerRatio(p.MaxValidatorPowerRatio); err != nil {
+		return err
+	}
+	if err := validatePowerRatio(p.SoftValidatorPowerRatio); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -194,6 +224,25 @@ func validateBondDenom(i interface{}) error {
 	return nil
 }
 
+func validatePowerRatio(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return errors.New("validator power ratio cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("validator power ratio cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("validator power ratio too large: %s", v)
+	}
+
+	return nil
+}
+
 // Equal returns a boolean determining if two Param types are identical.
 // TODO: This is slower than comparing struct fields directly
 func (p Params) Equal(p2 Params) bool {
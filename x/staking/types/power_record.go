@@ -0,0 +1,35 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	stakingexport "github.com/KuChainNetwork/kuchain/x/staking/exported"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidatorPowerRecord the consensus power of a validator sent to Tendermint and its real
+// bonded tokens from a height, recorded while the power is capped by MaxValidatorPowerRatio
+type ValidatorPowerRecord struct {
+	Power  int64     `json:"power" yaml:"power"`
+	Tokens sdk.Int   `json:"tokens" yaml:"tokens"`
+	Time   time.Time `json:"time" yaml:"time"`
+}
+
+// NewValidatorPowerRecord creates a new ValidatorPowerRecord
+func NewValidatorPowerRecord(power int64, tokens sdk.Int, time time.Time) ValidatorPowerRecord {
+	return ValidatorPowerRecord{
+		Power:  power,
+		Tokens: tokens,
+		Time:   time,
+	}
+}
+
+// IsCapped returns true if the power is less than the power of the real tokens
+func (r ValidatorPowerRecord) IsCapped() bool {
+	return stakingexport.TokensToConsensusPower(r.Tokens) > r.Power
+}
+
+func (r ValidatorPowerRecord) String() string {
+	return fmt.Sprintf("power %d tokens %s from %s", r.Power, r.Tokens, r.Time)
+}
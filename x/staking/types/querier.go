@@ -23,6 +23,7 @@ const (
 	QueryParameters                    = "parameters"
 	QueryHistoricalInfo                = "historicalInfo"
 	QueryValidatorByConsAddr           = "validatorByConsAddr"
+	QueryValidatorPower                = "validatorPower"
 )
 
 // defines the params for the following queries:
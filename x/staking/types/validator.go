@@ -441,3 +441,28 @@ func (v Validator) GetConsensusPower() int64      { return v.ConsensusPower() }
 func (v Validator) GetCommission() sdk.Dec        { return v.Commission.Rate }
 func (v Validator) GetMinSelfDelegation() sdk.Int { return v.MinSelfDelegation }
 func (v Validator) GetDelegatorShares() sdk.Dec   { return v.DelegatorShares }
+
+// ValidatorPower is the voting power of a validator, EffectivePower is the power
+// in consensus after the voting power cap applied.
+type ValidatorPower struct {
+	OperatorAccount types.AccountID `json:"operator_account" yaml:"operator_account"`
+	Power           int64           `json:"power" yaml:"power"`
+	EffectivePower  int64           `json:"effective_power" yaml:"effective_power"`
+	TotalPower      sdk.Int         `json:"total_power" yaml:"total_power"`
+}
+
+// NewValidatorPower creates a new ValidatorPower instance
+func NewValidatorPower(operator types.AccountID, power, effectivePower int64, totalPower sdk.Int) ValidatorPower {
+	return ValidatorPower{
+		OperatorAccount: operator,
+		Power:           power,
+		EffectivePower:  effectivePower,
+		TotalPower:      totalPower,
+	}
+}
+
+// String implements the Stringer interface for ValidatorPower.
+func (p ValidatorPower) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}